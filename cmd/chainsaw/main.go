@@ -3,6 +3,9 @@ package main
 import (
 	"context"
 	"crypto/sha256"
+	"database/sql"
+	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
 	"log/slog"
@@ -263,8 +266,12 @@ func indentLines(text, indent string) string {
 }
 
 func handleSearch() {
-	if len(os.Args) < 3 {
-		fmt.Println("Usage: chainsaw search <query>")
+	searchFlags := flag.NewFlagSet("search", flag.ExitOnError)
+	timeout := searchFlags.Duration("timeout", 0, "Give up on the search after this long, e.g. 30s (default: no limit)")
+
+	positional := parseInterspersed(searchFlags, os.Args[2:])
+	if len(positional) == 0 {
+		fmt.Println("Usage: chainsaw search [--timeout d] <query>")
 		fmt.Println()
 		fmt.Println("Searches indexed code using semantic similarity.")
 		fmt.Println("Results are automatically scoped to the current directory and subdirectories.")
@@ -276,7 +283,7 @@ func handleSearch() {
 		os.Exit(1)
 	}
 
-	query := os.Args[2]
+	query := positional[0]
 
 	// Get current working directory for path filtering
 	cwd, err := os.Getwd()
@@ -320,13 +327,17 @@ func handleSearch() {
 		BaseURL: "http://localhost:11434",
 	})
 
+	// Ctrl-C and --timeout interrupt the search
+	ctx, stop := queryContext(*timeout)
+	defer stop()
+
 	// Generate embedding for query
 	fmt.Printf("🔍 Searching for: %s\n\n", query)
-	ctx := context.Background()
 	embeddings, err := ollamaClient.Embed(ctx, "nomic-embed-text", []string{query}, 1)
 	if err != nil {
+		err = interrupted(ctx, err)
 		fmt.Fprintf(os.Stderr, "Error generating embedding: %v\n", err)
-		os.Exit(1)
+		os.Exit(exitCode(err))
 	}
 	if len(embeddings) == 0 {
 		fmt.Fprintf(os.Stderr, "No embedding generated\n")
@@ -335,10 +346,11 @@ func handleSearch() {
 	embedding := embeddings[0]
 
 	// Search with relations (depth=1 for direct connections) WITH path filtering
-	results, err := database.SearchWithRelations(embedding, 10, 1, pathFilter)
+	results, err := database.SearchWithRelationsContext(ctx, embedding, 10, 1, pathFilter)
 	if err != nil {
+		err = interrupted(ctx, err)
 		fmt.Fprintf(os.Stderr, "Error searching: %v\n", err)
-		os.Exit(1)
+		os.Exit(exitCode(err))
 	}
 
 	if len(results) == 0 {
@@ -501,6 +513,10 @@ func handleGraph() {
 	switch subcommand {
 	case "query":
 		handleGraphQuery()
+	case "schema":
+		handleGraphSchema()
+	case "shell":
+		handleGraphShell()
 	default:
 		fmt.Printf("Unknown graph subcommand: %s\n", subcommand)
 		printGraphUsage()
@@ -513,6 +529,28 @@ func printGraphUsage() {
 
 Subcommands:
   query <cypher>    Query the knowledge graph using Cypher syntax
+  schema            List the entity types, relation types and which types
+                    each relation connects, with counts and examples
+                    (takes --all and --scope)
+  shell             Run queries interactively on one open database, with
+                    history and tab completion (takes --all, --scope and
+                    --row-cap; type :help inside)
+
+Query flags:
+  --all             Query every indexed project (no directory scoping)
+  --scope SCOPE     Which end of each relation must lie under the current
+                    directory: either (default), source, target, both
+  --param NAME=VAL  Bind $NAME in the query (repeatable). JSON values such as
+                    42, true or ["a","b"] keep their type, others are strings
+  --params FILE     Read parameter values from a JSON object file
+  --row-cap N       Fail a variable-length relation after N intermediate
+                    rows (default 100000)
+  --explain         Print the generated SQL, its args and SQLite's query
+                    plan instead of running the query (or prefix EXPLAIN)
+  --profile         Also report rows and time per stage (or prefix PROFILE)
+  --error-format F  Print query errors as text (default) or json
+  --timeout D       Give up on the query after D, e.g. 30s or 2m; Ctrl-C
+                    also interrupts a running query
 
 Examples:
   # Find what functions call other functions
@@ -527,11 +565,69 @@ Examples:
   # Find what implements interfaces (reverse relation)
   chainsaw graph query "MATCH (i:INTERFACE)<-[:implements]-(s) RETURN i.name, s.name, s.snippet"
 
+  # Find handlers that call a service using a struct
+  chainsaw graph query "MATCH (h:FUNCTION)-[:calls]->(s)-[:uses]->(t:STRUCT) RETURN h.name, s.name, t.name"
+
+  # Find the callers of a single function
+  chainsaw graph query "MATCH (f:FUNCTION)-[:calls]->(t) WHERE t.name = 'IndexFile' RETURN f.name"
+
+  # Find calls made from this directory into any project
+  chainsaw graph query --scope source "MATCH (f)-[:calls]->(t) RETURN f.name, t.name, t.file"
+
+  # Filter on an aggregate, then keep matching
+  chainsaw graph query "MATCH (f)-[:calls]->(t) WITH t, COUNT(f) AS callers WHERE callers > 5 MATCH (t)-[:uses]->(x) RETURN t.name, x.name"
+
+  # Pass values as parameters instead of splicing them into the query
+  chainsaw graph query --param name=IndexFile "MATCH (f)-[:calls]->(t {name: $name}) RETURN f.name"
+
+  # Find callers of code about retries
+  chainsaw graph query "CALL chainsaw.search('retry with backoff', 20) YIELD node MATCH (f)-[:calls]->(node) RETURN f.name, node.name"
+
+  # See which relation types connect structs to other types
+  chainsaw graph query "CALL db.schema() YIELD source, relationship, target WHERE source = 'STRUCT' RETURN relationship, target"
+
+  # See which indexes a multi-hop query uses
+  chainsaw graph query "EXPLAIN MATCH (a)-[:calls*1..3]->(b) RETURN a.name, b.name"
+
 Supported patterns:
   (var:LABEL)       Node with label (entity type)
   (var)             Node without label (any type)
+  (:LABEL), ()      Anonymous node with or without label
+  (var:LABEL {name: 'x', file: '/abs/a.go'})
+                    Node with inline property filters
   -[:type]->        Forward relation
   <-[:type]-        Backward relation
+  -[:type]-         Either direction
+  -[:a|b|c]->       Any of several relation types
+  -[:type*1..3]->   Variable-length relation (any of the above);
+                    -[:type*]-> means 1 to 10 hops
+  -[r:type*1..3]->  Named variable-length relation; length(r) is its
+                    hop count
+  -[r:type]->       Named relation; RETURN r.type, r.weight, r.file,
+                    r.lines, r.chunk or type(r)
+  (a)-[:r]->(b)-[:s]->(c)
+                    Chain of any length, joined on shared nodes
+  (a)-[:r]->(b), (b)-[:s]->(c)
+                    Patterns separated by commas (or in several MATCH
+                    clauses) share entities by variable name
+  OPTIONAL MATCH    Keep rows without a match, returning NULLs
+  p = (a)-[:calls*]->(b)
+                    Path variable; RETURN p, nodes(p) or length(p)
+  p = shortestPath((a)-[:calls*]->(b))
+                    Only the shortest path between a and b
+
+WHERE predicates:
+  =, <>, <, >, <=, >=       Compare a property with a literal or property
+  x.name IN ['a', 'b']      List membership
+  STARTS WITH, ENDS WITH,   String matching (case-sensitive)
+  CONTAINS
+  x.file =~ '.*_test\.go'   Regular expression matching the whole value
+  [NOT] (a)<-[:calls]-()    Whether a relation exists for a matched node
+  EXISTS { MATCH ... }      Same for patterns with their own WHERE
+  x.name IS [NOT] NULL      Null checks
+  similar(x, 'text') < 0.4  Cosine distance (0 to 2) between x's code and
+                            the text; also usable in RETURN
+  AND, OR, NOT, ( )         Boolean logic
 
 RETURN properties:
   var.name          Entity name
@@ -540,18 +636,119 @@ RETURN properties:
   var.file          File path where entity found
   var.lines         Line range (e.g. "42-58")
 
+Aggregates (grouped by the other RETURN items unless GROUP BY is given):
+  COUNT(var), COUNT(*), COUNT(var.prop)
+  SUM, AVG, MIN, MAX(var.prop)
+  COLLECT(var.prop)  List of values, printed as a YAML list
+
+Semantic search:
+  CALL chainsaw.search('text', 20) YIELD node, distance MATCH (node)-...
+                       Start from the entities of the 20 chunks nearest
+                       to the text (YIELD node AS f renames it)
+
+Schema procedures (alone, or followed by YIELD ... [WHERE ...] RETURN):
+  CALL db.labels()             label, count of each entity type
+  CALL db.relationshipTypes()  relationshipType, count of each relation type
+  CALL db.schema()             source, relationship, target, count, example
+                               for each combination of types
+
+Pipelines:
+  WITH a, COUNT(b) AS n   Pass nodes and named values on to the next stage
+  WITH ... WHERE n > 5    Filter on the passed values
+  WITH DISTINCT ...       Drop duplicate rows first
+
+Result clauses (in this order, all optional):
+  RETURN DISTINCT ...  Drop duplicate rows
+  GROUP BY a.prop      Group aggregates explicitly
+  ORDER BY col [DESC]  Sort by a returned column
+  SKIP n LIMIT m       Page through results
+
+Combining queries:
+  ... RETURN a.name AS x UNION [ALL] MATCH ... RETURN b.name AS x
+                       Append rows of queries returning the same columns;
+                       UNION drops duplicates, UNION ALL keeps them
+
 Entity types: FUNCTION, METHOD, TYPE, INTERFACE, STRUCT, PACKAGE, VARIABLE, etc.
 Relation types: calls, uses, imports, implements, extends, etc.`)
 }
 
+// parseInterspersed parses flags that may appear before or after positional
+// arguments and returns the positional arguments in order.
+func parseInterspersed(fs *flag.FlagSet, args []string) []string {
+	var positional []string
+	for {
+		fs.Parse(args)
+		rest := fs.Args()
+		if len(rest) == 0 {
+			return positional
+		}
+		positional = append(positional, rest[0])
+		args = rest[1:]
+	}
+}
+
+// paramFlags collects repeated --param name=value query parameters
+type paramFlags map[string]interface{}
+
+func (p paramFlags) String() string {
+	return ""
+}
+
+func (p paramFlags) Set(binding string) error {
+	name, value, err := cypher.ParseParam(binding)
+	if err != nil {
+		return err
+	}
+	p[name] = value
+	return nil
+}
+
 func handleGraphQuery() {
-	if len(os.Args) < 4 {
-		fmt.Println("Usage: chainsaw graph query <cypher>")
+	queryFlags := flag.NewFlagSet("graph-query", flag.ExitOnError)
+	all := queryFlags.Bool("all", false, "Query every indexed project instead of scoping to the current directory")
+	scopeName := queryFlags.String("scope", "either", "Which end of each relation must lie under the current directory: either, source, target, both")
+	paramsFile := queryFlags.String("params", "", "JSON file with values for $parameters")
+	params := paramFlags{}
+	queryFlags.Var(params, "param", "Value for a $parameter as name=value (repeatable)")
+	rowCap := queryFlags.Int("row-cap", cypher.DefaultRowCap, "Max intermediate rows of a variable-length relation before the query fails")
+	explain := queryFlags.Bool("explain", false, "Print the generated SQL, its args and SQLite's query plan instead of running the query")
+	profile := queryFlags.Bool("profile", false, "Run the query and also report rows and elapsed time per stage")
+	timeout := queryFlags.Duration("timeout", 0, "Give up on the query after this long, e.g. 30s (default: no limit)")
+	errorFormat := queryFlags.String("error-format", "text", "How to print query errors: text or json")
+
+	positional := parseInterspersed(queryFlags, os.Args[3:])
+	if len(positional) != 1 {
+		fmt.Println("Usage: chainsaw graph query [--all] [--scope either|source|target|both] [--param name=value]... [--params file.json] [--row-cap n] [--explain|--profile] [--error-format text|json] [--timeout d] <cypher>")
 		fmt.Println("Example: chainsaw graph query \"MATCH (f:FUNCTION)-[:calls]->(t) RETURN f.name, t.name\"")
 		os.Exit(1)
 	}
 
-	cypherQuery := os.Args[3]
+	cypherQuery := positional[0]
+
+	if *errorFormat != "text" && *errorFormat != "json" {
+		fmt.Fprintf(os.Stderr, "Error: unknown error format %q (want text or json)\n", *errorFormat)
+		os.Exit(1)
+	}
+
+	scope, err := cypher.ParseScope(*scopeName)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Values from --param take precedence over the params file
+	if *paramsFile != "" {
+		fileParams, err := cypher.LoadParamsFile(*paramsFile)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		for name, value := range fileParams {
+			if _, ok := params[name]; !ok {
+				params[name] = value
+			}
+		}
+	}
 
 	// Open database
 	dbPath := filepath.Join(os.Getenv("HOME"), ".chainsaw", "chainsaw.db")
@@ -566,93 +763,222 @@ func handleGraphQuery() {
 	}
 	defer database.Close()
 
-	// Get CWD for path filtering
-	cwd, err := os.Getwd()
-	if err != nil {
-		cwd = "" // Fall back to no filtering
-	} else {
-		// Convert to absolute path
-		cwd, err = filepath.Abs(cwd)
-		if err != nil {
-			cwd = ""
-		}
-	}
+	// Ctrl-C and --timeout interrupt the query
+	ctx, stop := queryContext(*timeout)
+	defer stop()
 
 	// Transpile Cypher to SQL with CWD filtering
 	result, err := cypher.Transpile(cypherQuery, cypher.TranspileOptions{
-		CWD: cwd,
+		CWD:    queryCWD(*all),
+		Scope:  scope,
+		Params: params,
+		RowCap: *rowCap,
+		Embed:  queryEmbedder(ctx),
 	})
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error parsing Cypher query: %v\n", err)
+		if ctx.Err() != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", context.Cause(ctx))
+			os.Exit(exitCode(context.Cause(ctx)))
+		}
+		printQueryError(err, *errorFormat)
 		os.Exit(1)
 	}
 
-	// Execute the generated SQL
-	rows, err := database.RawQuery(result.SQL, result.Args...)
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error executing query: %v\n", err)
-		fmt.Fprintf(os.Stderr, "Generated SQL:\n%s\n", result.SQL)
-		fmt.Fprintf(os.Stderr, "Args: %v\n", result.Args)
-		os.Exit(1)
+	// --explain and --profile override an EXPLAIN or PROFILE prefix
+	mode := result.Mode
+	if *explain {
+		mode = cypher.ModeExplain
+	} else if *profile {
+		mode = cypher.ModeProfile
 	}
-	defer rows.Close()
 
-	// Get column names
-	columns, err := rows.Columns()
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error getting columns: %v\n", err)
-		os.Exit(1)
+	if err := runGraphQuery(ctx, database, cypherQuery, result, mode, "yaml"); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", rowCapHint(err))
+		os.Exit(exitCode(err))
 	}
+}
 
-	// Scan all rows first
-	var allRows [][]string
-	values := make([]interface{}, len(columns))
-	valuePtrs := make([]interface{}, len(columns))
-	for i := range values {
-		valuePtrs[i] = &values[i]
+// errQueryCancelled is why a query interrupted with Ctrl-C stopped
+var errQueryCancelled = errors.New("query cancelled")
+
+// queryContext returns the context a query runs in. Ctrl-C cancels it, and
+// so does a positive timeout expiring; either interrupts SQLite or the
+// embedding request in progress. A second Ctrl-C kills the process as
+// usual. Call stop once the query is done.
+func queryContext(timeout time.Duration) (context.Context, func()) {
+	ctx, cancel := context.WithCancelCause(context.Background())
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	go func() {
+		select {
+		case <-interrupt:
+			// A second Ctrl-C kills the process
+			signal.Stop(interrupt)
+			cancel(errQueryCancelled)
+		case <-ctx.Done():
+		}
+	}()
+	// Stop catching Ctrl-C before the query is cancelled, so one pressed
+	// right after it is not swallowed by a watcher that has yet to exit
+	stop := func() {
+		signal.Stop(interrupt)
+		cancel(nil)
 	}
 
-	for rows.Next() {
-		err := rows.Scan(valuePtrs...)
+	if timeout <= 0 {
+		return ctx, stop
+	}
+	ctx, cancelTimeout := context.WithTimeoutCause(ctx, timeout, fmt.Errorf("query timed out after %s", timeout))
+	return ctx, func() {
+		stop()
+		cancelTimeout()
+	}
+}
+
+// interrupted replaces the error of a query whose context ended with the
+// reason it ended, as SQLite only reports that it was interrupted
+func interrupted(ctx context.Context, err error) error {
+	if err != nil && ctx.Err() != nil {
+		return context.Cause(ctx)
+	}
+	return err
+}
+
+// rowCapHint adds what to do about a variable-length relation that
+// exceeded --row-cap to the error of the query
+func rowCapHint(err error) error {
+	if db.IsRowCapError(err) {
+		return fmt.Errorf("%w\nAdd labels or relation types, lower the maximum depth or raise --row-cap", err)
+	}
+	return err
+}
+
+// exitCode is the exit status for a failed query: 130 after Ctrl-C, like a
+// process killed by SIGINT, and 1 otherwise
+func exitCode(err error) int {
+	if errors.Is(err, errQueryCancelled) {
+		return 130
+	}
+	return 1
+}
+
+// queryFailure is a generated statement SQLite rejected
+type queryFailure struct {
+	action string
+	err    error
+	result *cypher.TranspileResult
+}
+
+func (f *queryFailure) Error() string {
+	return fmt.Sprintf("failed to %s: %v\nGenerated SQL:\n%s\nArgs: %v", f.action, f.err, f.result.SQL, f.result.Args)
+}
+
+// runGraphQuery runs a transpiled query and prints its results as yaml or
+// json. EXPLAIN prints the SQL and query plan instead; PROFILE adds them and
+// the rows and time of every stage to the results.
+func runGraphQuery(ctx context.Context, database *db.DB, cypherQuery string, result *cypher.TranspileResult, mode cypher.Mode, format string) error {
+	var plan string
+	var err error
+	if mode != cypher.ModeRun {
+		plan, err = database.QueryPlanContext(ctx, result.SQL, result.Args...)
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Error scanning row: %v\n", err)
-			continue
+			return interrupted(ctx, &queryFailure{"plan query", err, result})
+		}
+	}
+	if mode == cypher.ModeExplain {
+		if format == "json" {
+			args := result.Args
+			if args == nil {
+				args = []interface{}{}
+			}
+			return printJSON(struct {
+				Query string        `json:"query"`
+				SQL   string        `json:"sql"`
+				Args  []interface{} `json:"args"`
+				Plan  string        `json:"plan"`
+			}{cypherQuery, result.SQL, args, plan})
 		}
+		fmt.Printf("query: %q\n", cypherQuery)
+		printQueryPlan(result, plan)
+		return nil
+	}
 
-		rowData := make([]string, len(columns))
-		for i, val := range values {
-			if val == nil {
-				rowData[i] = "NULL"
-			} else {
-				switch v := val.(type) {
-				case []byte:
-					rowData[i] = string(v)
-				default:
-					rowData[i] = fmt.Sprintf("%v", v)
-				}
+	// PROFILE counts the rows of every CTE before running the query
+	var stages []stageProfile
+	if mode == cypher.ModeProfile {
+		for _, stage := range result.Stages {
+			start := time.Now()
+			count, err := countRows(ctx, database, stage.SQL, stage.Args)
+			if err != nil {
+				return interrupted(ctx, fmt.Errorf("failed to profile stage %s: %w", stage.Name, err))
 			}
+			stages = append(stages, stageProfile{stage.Name, count, time.Since(start)})
 		}
-		allRows = append(allRows, rowData)
 	}
 
-	if err := rows.Err(); err != nil {
-		fmt.Fprintf(os.Stderr, "Error iterating rows: %v\n", err)
-		os.Exit(1)
+	// Execute the generated SQL
+	start := time.Now()
+	rows, err := database.RawQueryContext(ctx, result.SQL, result.Args...)
+	if err != nil {
+		return interrupted(ctx, &queryFailure{"execute query", err, result})
+	}
+	columns, allRows, err := scanRows(rows)
+	if err != nil {
+		return interrupted(ctx, err)
+	}
+	elapsed := time.Since(start)
+
+	lists := map[string]bool{}
+	for _, col := range result.Lists {
+		lists[col] = true
+	}
+
+	if format == "json" {
+		report := queryReport{Query: cypherQuery, Results: []resultRow{}, Total: len(allRows)}
+		if mode == cypher.ModeProfile {
+			report.SQL, report.Args, report.Plan = result.SQL, result.Args, plan
+			report.Profile = &profileReport{Rows: len(allRows), Time: elapsed.Round(time.Microsecond).String()}
+			for _, stage := range stages {
+				report.Profile.Stages = append(report.Profile.Stages, stageReport{stage.name, stage.rows, stage.elapsed.Round(time.Microsecond).String()})
+			}
+		}
+		for _, row := range allRows {
+			report.Results = append(report.Results, resultRow{columns, row, lists})
+		}
+		return printJSON(report)
 	}
 
 	// Print results in YAML format (better for LLM consumption)
 	fmt.Printf("query: %q\n", cypherQuery)
+	if mode == cypher.ModeProfile {
+		printQueryPlan(result, plan)
+		fmt.Println("profile:")
+		if len(stages) > 0 {
+			fmt.Println("  stages:")
+			for _, stage := range stages {
+				fmt.Printf("    - name: %s\n", stage.name)
+				fmt.Printf("      rows: %d\n", stage.rows)
+				fmt.Printf("      time: %s\n", stage.elapsed.Round(time.Microsecond))
+			}
+		}
+		fmt.Printf("  rows: %d\n", len(allRows))
+		fmt.Printf("  time: %s\n", elapsed.Round(time.Microsecond))
+	}
 
 	if len(allRows) == 0 {
 		fmt.Println("results: []")
-		return
+		return nil
 	}
 
 	fmt.Println("results:")
 	for idx, row := range allRows {
 		fmt.Printf("  - index: %d\n", idx)
 		for i, col := range columns {
-			val := row[i]
+			val := formatValue(row[i])
+			if lists[col] {
+				printYAMLList(col, val)
+				continue
+			}
 			// Check if value contains newlines (like code snippets)
 			if strings.Contains(val, "\n") {
 				// Multi-line YAML literal block
@@ -671,6 +997,343 @@ func handleGraphQuery() {
 		}
 	}
 	fmt.Printf("\ntotal: %d\n", len(allRows))
+	return nil
+}
+
+// queryReport is the JSON output of a graph query
+type queryReport struct {
+	Query   string         `json:"query"`
+	SQL     string         `json:"sql,omitempty"`
+	Args    []interface{}  `json:"args,omitempty"`
+	Plan    string         `json:"plan,omitempty"`
+	Profile *profileReport `json:"profile,omitempty"`
+	Results []resultRow    `json:"results"`
+	Total   int            `json:"total"`
+}
+
+type profileReport struct {
+	Stages []stageReport `json:"stages,omitempty"`
+	Rows   int           `json:"rows"`
+	Time   string        `json:"time"`
+}
+
+type stageReport struct {
+	Name string `json:"name"`
+	Rows int    `json:"rows"`
+	Time string `json:"time"`
+}
+
+// resultRow marshals a result row as a JSON object with its columns in
+// query order. COLLECT columns are JSON arrays already.
+type resultRow struct {
+	columns []string
+	values  []interface{}
+	lists   map[string]bool
+}
+
+func (r resultRow) MarshalJSON() ([]byte, error) {
+	var buf strings.Builder
+	buf.WriteString("{")
+	for i, col := range r.columns {
+		if i > 0 {
+			buf.WriteString(",")
+		}
+		key, err := json.Marshal(col)
+		if err != nil {
+			return nil, err
+		}
+		var value []byte
+		if text, ok := r.values[i].(string); ok && r.lists[col] && json.Valid([]byte(text)) {
+			value = []byte(text)
+		} else if value, err = json.Marshal(r.values[i]); err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteString(":")
+		buf.Write(value)
+	}
+	buf.WriteString("}")
+	return []byte(buf.String()), nil
+}
+
+// printJSON prints v as indented JSON
+func printJSON(v interface{}) error {
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+// schemaSections are the sections of graph schema output and the procedure
+// computing each
+var schemaSections = []struct {
+	name  string
+	query string
+}{
+	{"labels", "CALL db.labels()"},
+	{"relationship_types", "CALL db.relationshipTypes()"},
+	{"schema", "CALL db.schema()"},
+}
+
+func handleGraphSchema() {
+	schemaFlags := flag.NewFlagSet("graph-schema", flag.ExitOnError)
+	all := schemaFlags.Bool("all", false, "Describe every indexed project instead of scoping to the current directory")
+	scopeName := schemaFlags.String("scope", "either", "Which end of each relation must lie under the current directory: either, source, target, both")
+
+	if positional := parseInterspersed(schemaFlags, os.Args[3:]); len(positional) != 0 {
+		fmt.Println("Usage: chainsaw graph schema [--all] [--scope either|source|target|both]")
+		os.Exit(1)
+	}
+
+	scope, err := cypher.ParseScope(*scopeName)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	dbPath := filepath.Join(os.Getenv("HOME"), ".chainsaw", "chainsaw.db")
+	database, err := db.Open(db.Config{
+		Path:         dbPath,
+		SkipVecTable: false,
+		EmbeddingDim: 768,
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error opening database: %v\n", err)
+		os.Exit(1)
+	}
+	defer database.Close()
+
+	ctx, stop := queryContext(0)
+	defer stop()
+
+	opts := cypher.TranspileOptions{CWD: queryCWD(*all), Scope: scope}
+	if err := printSchema(ctx, database, opts, "yaml"); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(exitCode(err))
+	}
+}
+
+// printSchema prints the labels, relation types and schema of the graph in
+// the scope of opts as yaml or json
+func printSchema(ctx context.Context, database *db.DB, opts cypher.TranspileOptions, format string) error {
+	report := map[string][]resultRow{}
+	for _, section := range schemaSections {
+		result, err := cypher.Transpile(section.query, opts)
+		if err != nil {
+			return err
+		}
+		rows, err := database.RawQueryContext(ctx, result.SQL, result.Args...)
+		if err != nil {
+			return interrupted(ctx, &queryFailure{"execute " + section.query, err, result})
+		}
+		columns, allRows, err := scanRows(rows)
+		if err != nil {
+			return interrupted(ctx, err)
+		}
+
+		if format == "json" {
+			report[section.name] = []resultRow{}
+			for _, row := range allRows {
+				report[section.name] = append(report[section.name], resultRow{columns: columns, values: row})
+			}
+			continue
+		}
+		if len(allRows) == 0 {
+			fmt.Printf("%s: []\n", section.name)
+			continue
+		}
+		fmt.Printf("%s:\n", section.name)
+		for _, row := range allRows {
+			for i, col := range columns {
+				prefix := "    "
+				if i == 0 {
+					prefix = "  - "
+				}
+				if col == "example" {
+					fmt.Printf("%s%s: %q\n", prefix, col, formatValue(row[i]))
+				} else {
+					fmt.Printf("%s%s: %s\n", prefix, col, formatValue(row[i]))
+				}
+			}
+		}
+	}
+	if format == "json" {
+		return printJSON(report)
+	}
+	return nil
+}
+
+// queryCWD returns the absolute current directory that scopes graph queries,
+// or "" for none when --all is given or it cannot be determined
+func queryCWD(all bool) string {
+	if all {
+		return ""
+	}
+	cwd, err := os.Getwd()
+	if err != nil {
+		return ""
+	}
+	cwd, err = filepath.Abs(cwd)
+	if err != nil {
+		return ""
+	}
+	return cwd
+}
+
+// scanRows reads all rows of a query, with text as strings, and closes them
+func scanRows(rows *sql.Rows) ([]string, [][]interface{}, error) {
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get columns: %w", err)
+	}
+
+	var allRows [][]interface{}
+	for rows.Next() {
+		values := make([]interface{}, len(columns))
+		valuePtrs := make([]interface{}, len(columns))
+		for i := range values {
+			valuePtrs[i] = &values[i]
+		}
+		if err := rows.Scan(valuePtrs...); err != nil {
+			return nil, nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		for i, val := range values {
+			if b, ok := val.([]byte); ok {
+				values[i] = string(b)
+			}
+		}
+		allRows = append(allRows, values)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, nil, fmt.Errorf("failed to iterate rows: %w", err)
+	}
+	return columns, allRows, nil
+}
+
+// formatValue renders a scanned value as text, with NULL for SQL NULL
+func formatValue(val interface{}) string {
+	if val == nil {
+		return "NULL"
+	}
+	return fmt.Sprintf("%v", val)
+}
+
+// queryEmbedder embeds the texts of similar() and chainsaw.search with the
+// model that embedded the indexed chunks. Ollama is only contacted when a
+// query uses them, and the request ends with ctx.
+func queryEmbedder(ctx context.Context) func(string) ([]float32, error) {
+	ollamaClient := ollama.NewClient(&ollama.Config{
+		BaseURL: "http://localhost:11434",
+	})
+	return func(text string) ([]float32, error) {
+		embeddings, err := ollamaClient.Embed(ctx, "nomic-embed-text", []string{text}, 1)
+		if err != nil {
+			return nil, err
+		}
+		if len(embeddings) == 0 {
+			return nil, fmt.Errorf("no embedding generated")
+		}
+		return embeddings[0], nil
+	}
+}
+
+// printQueryError prints why a query could not be transpiled. Syntax errors
+// point at the offending token and may carry a hint; as JSON they also give
+// its position and the expected tokens.
+func printQueryError(err error, format string) {
+	var syntaxErr *cypher.SyntaxError
+	isSyntax := errors.As(err, &syntaxErr)
+	if format == "json" {
+		var report interface{} = map[string]string{"message": err.Error()}
+		if isSyntax {
+			report = syntaxErr
+		}
+		enc := json.NewEncoder(os.Stderr)
+		enc.SetEscapeHTML(false)
+		enc.SetIndent("", "  ")
+		enc.Encode(report)
+		return
+	}
+	if isSyntax {
+		fmt.Fprintf(os.Stderr, "Error parsing Cypher query: %s\n", syntaxErr.Detail())
+		return
+	}
+	fmt.Fprintf(os.Stderr, "Error parsing Cypher query: %v\n", err)
+}
+
+// stageProfile is the row count and elapsed time of one PROFILE stage
+type stageProfile struct {
+	name    string
+	rows    int
+	elapsed time.Duration
+}
+
+// countRows runs a SELECT COUNT(*) statement and returns the count
+func countRows(ctx context.Context, database *db.DB, query string, args []interface{}) (int, error) {
+	rows, err := database.RawQueryContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	count := 0
+	if rows.Next() {
+		if err := rows.Scan(&count); err != nil {
+			return 0, err
+		}
+	}
+	return count, rows.Err()
+}
+
+// printQueryPlan prints the generated SQL, its args and SQLite's query plan.
+// Blobs such as the embedding of similar() are shown by size only.
+func printQueryPlan(result *cypher.TranspileResult, plan string) {
+	fmt.Println("sql: |")
+	fmt.Println(indentLines(result.SQL, "  "))
+	args := make([]string, len(result.Args))
+	for i, arg := range result.Args {
+		switch v := arg.(type) {
+		case string:
+			args[i] = fmt.Sprintf("%q", v)
+		case []byte:
+			args[i] = fmt.Sprintf("<blob %d bytes>", len(v))
+		default:
+			args[i] = fmt.Sprintf("%v", v)
+		}
+	}
+	fmt.Printf("args: [%s]\n", strings.Join(args, ", "))
+	fmt.Println("plan: |")
+	fmt.Println(indentLines(plan, "  "))
+}
+
+// printYAMLList prints a JSON array column (from COLLECT) as a YAML list
+func printYAMLList(col, val string) {
+	var items []interface{}
+	if err := json.Unmarshal([]byte(val), &items); err != nil || len(items) == 0 {
+		fmt.Printf("    %s: []\n", col)
+		return
+	}
+	fmt.Printf("    %s:\n", col)
+	for _, item := range items {
+		switch v := item.(type) {
+		case nil:
+			fmt.Println("      - null")
+		case string:
+			if strings.Contains(v, "\n") {
+				fmt.Println("      - |")
+				for _, line := range strings.Split(v, "\n") {
+					fmt.Printf("        %s\n", line)
+				}
+			} else {
+				fmt.Printf("      - %q\n", v)
+			}
+		default:
+			fmt.Printf("      - %v\n", v)
+		}
+	}
 }
 
 // ============================================================================
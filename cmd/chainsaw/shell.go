@@ -0,0 +1,459 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/wouteroostervld/chainsaw/pkg/cypher"
+	"github.com/wouteroostervld/chainsaw/pkg/db"
+	"github.com/wouteroostervld/chainsaw/pkg/lineedit"
+)
+
+// graphShell is an interactive graph query session on one open database
+type graphShell struct {
+	database *db.DB
+	opts     cypher.TranspileOptions
+	format   string        // yaml or json
+	timeout  time.Duration // per query, 0 for none
+	last     string        // last query run, for :explain
+
+	// Completions, read from the database when the shell starts
+	labels        []string
+	relationTypes []string
+}
+
+// shellFunctions are the functions completed along with the keywords
+var shellFunctions = []string{"AVG", "COLLECT", "COUNT", "MAX", "MIN", "SUM", "length", "nodes", "similar", "size", "type"}
+
+// shellProperties are the properties of nodes and relationships
+var shellProperties = []string{"chunk", "entity_type", "file", "lines", "name", "snippet", "type", "weight"}
+
+// shellCommands are the meta-commands of the shell
+var shellCommands = []string{":cwd", ":explain", ":format", ":help", ":param", ":quit", ":schema"}
+
+func handleGraphShell() {
+	shellFlags := flag.NewFlagSet("graph-shell", flag.ExitOnError)
+	all := shellFlags.Bool("all", false, "Query every indexed project instead of scoping to the current directory")
+	scopeName := shellFlags.String("scope", "either", "Which end of each relation must lie under the current directory: either, source, target, both")
+	rowCap := shellFlags.Int("row-cap", cypher.DefaultRowCap, "Max intermediate rows of a variable-length relation before a query fails")
+	timeout := shellFlags.Duration("timeout", 0, "Give up on each query after this long, e.g. 30s (default: no limit)")
+
+	if positional := parseInterspersed(shellFlags, os.Args[3:]); len(positional) != 0 {
+		fmt.Println("Usage: chainsaw graph shell [--all] [--scope either|source|target|both] [--row-cap n] [--timeout d]")
+		os.Exit(1)
+	}
+
+	scope, err := cypher.ParseScope(*scopeName)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	// One connection serves every query of the session
+	dbPath := filepath.Join(os.Getenv("HOME"), ".chainsaw", "chainsaw.db")
+	database, err := db.Open(db.Config{
+		Path:         dbPath,
+		SkipVecTable: false,
+		EmbeddingDim: 768,
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error opening database: %v\n", err)
+		os.Exit(1)
+	}
+	defer database.Close()
+
+	shell := &graphShell{
+		database: database,
+		opts: cypher.TranspileOptions{
+			CWD:    queryCWD(*all),
+			Scope:  scope,
+			Params: map[string]interface{}{},
+			RowCap: *rowCap,
+		},
+		format:  "yaml",
+		timeout: *timeout,
+	}
+	if err := shell.loadCompletions(); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: could not read labels for completion: %v\n", err)
+	}
+
+	editor := lineedit.New(os.Stdin, os.Stdout)
+	editor.Complete = shell.complete
+
+	// Piped scripts are not recorded in the history
+	if editor.Interactive() {
+		historyPath := filepath.Join(os.Getenv("HOME"), ".chainsaw", "shell_history")
+		if err := editor.LoadHistory(historyPath); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+		}
+		fmt.Println("chainsaw graph shell. End queries with ; and type :help for commands.")
+		fmt.Println(shell.scopeDescription())
+	}
+	shell.run(editor)
+}
+
+// run reads queries and commands until the input ends or :quit. Queries
+// may span several lines and end with ;. Commands start with : and take a
+// single line.
+func (s *graphShell) run(editor *lineedit.Editor) {
+	var pending []string
+	for {
+		prompt := "chainsaw> "
+		if len(pending) > 0 {
+			prompt = "     ...> "
+		}
+		line, err := editor.ReadLine(prompt)
+		if err == lineedit.ErrInterrupt {
+			pending = nil
+			continue
+		}
+		if err == io.EOF {
+			// A piped script may leave off the last ;
+			if len(pending) > 0 {
+				s.execute(strings.Join(pending, "\n"))
+			}
+			return
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error reading input: %v\n", err)
+			return
+		}
+
+		trimmed := strings.TrimSpace(line)
+		if len(pending) == 0 {
+			if trimmed == "" {
+				continue
+			}
+			if strings.HasPrefix(trimmed, ":") {
+				if err := editor.AddHistory(trimmed); err != nil {
+					fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+				}
+				if quit := s.command(trimmed); quit {
+					return
+				}
+				continue
+			}
+		}
+
+		pending = append(pending, line)
+		if !strings.HasSuffix(trimmed, ";") {
+			continue
+		}
+		statement := strings.Join(pending, "\n")
+		pending = nil
+		if err := editor.AddHistory(statement); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+		}
+		s.execute(statement)
+	}
+}
+
+// execute runs a query typed at the prompt
+func (s *graphShell) execute(statement string) {
+	query := strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(statement), ";"))
+	if query == "" {
+		return
+	}
+	s.last = query
+	s.runQuery(query, false)
+}
+
+// runQuery transpiles and runs a query, or only explains it. Errors are
+// reported without leaving the shell, and Ctrl-C or the timeout interrupt
+// the query rather than the shell.
+func (s *graphShell) runQuery(query string, explain bool) {
+	ctx, stop := queryContext(s.timeout)
+	defer stop()
+
+	opts := s.opts
+	opts.Embed = queryEmbedder(ctx)
+	result, err := cypher.Transpile(query, opts)
+	if err != nil {
+		if ctx.Err() != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", context.Cause(ctx))
+			return
+		}
+		printQueryError(err, s.errorFormat())
+		return
+	}
+
+	mode := result.Mode
+	if explain {
+		mode = cypher.ModeExplain
+	}
+	if err := runGraphQuery(ctx, s.database, query, result, mode, s.format); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", rowCapHint(err))
+	}
+}
+
+// command runs a meta-command and reports whether it ends the session
+func (s *graphShell) command(line string) bool {
+	name, arg, _ := strings.Cut(line, " ")
+	arg = strings.TrimSpace(arg)
+
+	switch name {
+	case ":quit", ":exit", ":q":
+		return true
+
+	case ":help":
+		printShellHelp()
+
+	case ":explain":
+		query := strings.TrimSpace(strings.TrimSuffix(arg, ";"))
+		if query == "" {
+			query = s.last
+		}
+		if query == "" {
+			fmt.Fprintln(os.Stderr, "Nothing to explain; give a query, e.g. :explain MATCH (f)-[:calls]->(t) RETURN f.name")
+			return false
+		}
+		s.runQuery(query, true)
+
+	case ":schema":
+		ctx, stop := queryContext(s.timeout)
+		err := printSchema(ctx, s.database, s.opts, s.format)
+		stop()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		}
+
+	case ":format":
+		switch arg {
+		case "":
+			fmt.Println(s.format)
+		case "yaml", "json":
+			s.format = arg
+		default:
+			fmt.Fprintf(os.Stderr, "Unknown format %q; use :format yaml or :format json\n", arg)
+		}
+
+	case ":cwd":
+		if arg != "" {
+			if err := s.changeScope(arg); err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				return false
+			}
+		}
+		fmt.Println(s.scopeDescription())
+
+	case ":param":
+		if arg == "" {
+			s.printParams()
+			return false
+		}
+		name, value, err := cypher.ParseParam(arg)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return false
+		}
+		s.opts.Params[name] = value
+
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown command %s; type :help for commands\n", name)
+	}
+	return false
+}
+
+// changeScope scopes later queries to dir, relative to the current scope,
+// or to every project for "all"
+func (s *graphShell) changeScope(dir string) error {
+	if dir == "all" {
+		s.opts.CWD = ""
+		return nil
+	}
+	if dir == "~" || strings.HasPrefix(dir, "~/") {
+		dir = filepath.Join(os.Getenv("HOME"), dir[1:])
+	}
+	if !filepath.IsAbs(dir) {
+		base := s.opts.CWD
+		if base == "" {
+			base = queryCWD(false)
+		}
+		dir = filepath.Join(base, dir)
+	}
+	info, err := os.Stat(dir)
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("%s is not a directory", dir)
+	}
+	s.opts.CWD = filepath.Clean(dir)
+	return nil
+}
+
+// scopeDescription says which projects queries are scoped to
+func (s *graphShell) scopeDescription() string {
+	if s.opts.CWD == "" {
+		return "Querying every indexed project"
+	}
+	scope := string(s.opts.Scope)
+	if s.opts.Scope == cypher.ScopeEither {
+		scope = "either"
+	}
+	return fmt.Sprintf("Querying %s (scope %s)", s.opts.CWD, scope)
+}
+
+// printParams lists the bound parameters
+func (s *graphShell) printParams() {
+	if len(s.opts.Params) == 0 {
+		fmt.Println("No parameters; bind one with :param name=value")
+		return
+	}
+	var names []string
+	for name := range s.opts.Params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		value, _ := json.Marshal(s.opts.Params[name])
+		fmt.Printf("$%s = %s\n", name, value)
+	}
+}
+
+// errorFormat is how query errors are printed in the current output format
+func (s *graphShell) errorFormat() string {
+	if s.format == "json" {
+		return "json"
+	}
+	return "text"
+}
+
+// loadCompletions reads the labels and relation types of the whole graph
+func (s *graphShell) loadCompletions() error {
+	var err error
+	if s.labels, err = s.firstColumn("CALL db.labels() YIELD label RETURN label"); err != nil {
+		return err
+	}
+	s.relationTypes, err = s.firstColumn("CALL db.relationshipTypes() YIELD relationshipType RETURN relationshipType")
+	return err
+}
+
+// firstColumn runs a query over every project and returns its first column
+func (s *graphShell) firstColumn(query string) ([]string, error) {
+	result, err := cypher.Transpile(query, cypher.TranspileOptions{})
+	if err != nil {
+		return nil, err
+	}
+	rows, err := s.database.RawQuery(result.SQL, result.Args...)
+	if err != nil {
+		return nil, err
+	}
+	_, allRows, err := scanRows(rows)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]string, len(allRows))
+	for i, row := range allRows {
+		values[i] = formatValue(row[0])
+	}
+	return values, nil
+}
+
+// complete completes the word before the cursor: labels after ( and :,
+// relation types after [ and : or |, properties after a dot, procedures
+// after CALL, and otherwise keywords and functions
+func (s *graphShell) complete(head string) (int, []string) {
+	if strings.HasPrefix(head, ":") {
+		name, arg, found := strings.Cut(head, " ")
+		switch {
+		case !found:
+			return 0, matchPrefix(shellCommands, head)
+		case name == ":format":
+			return len(head) - len(arg), matchPrefix([]string{"json", "yaml"}, arg)
+		}
+		return 0, nil
+	}
+
+	start := len(head)
+	for start > 0 && isWordByte(head[start-1]) {
+		start--
+	}
+	word, before := head[start:], head[:start]
+	if before == "" {
+		return start, matchPrefix(cypher.Keywords(), word)
+	}
+
+	inRelation := strings.LastIndex(before, "[") > strings.LastIndex(before, "]")
+	switch before[len(before)-1] {
+	case ':':
+		if inRelation {
+			return start, matchPrefix(s.relationTypes, word)
+		}
+		return start, matchPrefix(s.labels, word)
+	case '|':
+		if inRelation {
+			return start, matchPrefix(s.relationTypes, word)
+		}
+		return start, nil
+	case '.':
+		owner := len(before) - 1
+		for owner > 0 && isWordByte(before[owner-1]) {
+			owner--
+		}
+		namespace := before[owner : len(before)-1]
+		if namespace == "db" || namespace == "chainsaw" {
+			var names []string
+			for _, procedure := range cypher.Procedures() {
+				if rest, ok := strings.CutPrefix(procedure, namespace+"."); ok {
+					names = append(names, rest)
+				}
+			}
+			return start, matchPrefix(names, word)
+		}
+		return start, matchPrefix(shellProperties, word)
+	}
+
+	if word == "" {
+		return start, nil
+	}
+	return start, matchPrefix(append(cypher.Keywords(), shellFunctions...), word)
+}
+
+// matchPrefix returns the words starting with prefix, ignoring case
+func matchPrefix(words []string, prefix string) []string {
+	var matches []string
+	for _, w := range words {
+		if len(w) >= len(prefix) && strings.EqualFold(w[:len(prefix)], prefix) {
+			matches = append(matches, w)
+		}
+	}
+	return matches
+}
+
+// isWordByte reports whether b can be part of a name
+func isWordByte(b byte) bool {
+	return b == '_' || b >= 'a' && b <= 'z' || b >= 'A' && b <= 'Z' || b >= '0' && b <= '9'
+}
+
+func printShellHelp() {
+	fmt.Println(`Enter Cypher queries ending with ; they may span several lines. EXPLAIN
+and PROFILE work as in chainsaw graph query.
+
+Commands:
+  :explain [query]    Show the SQL and query plan of a query, or of the
+                      last one run
+  :schema             List entity types, relation types and the types each
+                      relation connects
+  :format yaml|json   Print results as yaml (default) or json
+  :cwd [dir|all]      Show or change the directory queries are scoped to;
+                      :cwd all queries every project
+  :param name=value   Bind $name for later queries; :param alone lists them
+  :help               Show this help
+  :quit               Leave the shell (or press Ctrl-D)
+
+Keys:
+  Tab                 Complete labels, relation types, properties,
+                      procedures and keywords
+  Up, Down            Recall earlier queries, kept in ~/.chainsaw/shell_history
+  Ctrl-C              Discard the query being typed, or interrupt the
+                      query running`)
+}
@@ -2,6 +2,9 @@ package ast
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
+
 	"github.com/wouteroostervld/chainsaw/pkg/cypher/token"
 )
 
@@ -10,57 +13,200 @@ type Attrib interface{}
 
 // Query represents a complete Cypher query
 type Query struct {
-	Match   *MatchClause
+	Call    *CallClause  // CALL ... YIELD seeding the first stage, if any
+	Stages  []*WithStage // MATCH ... WITH stages feeding the final part
+	Matches []*MatchClause
 	Return  *ReturnClause
 	GroupBy *GroupByClause
 	OrderBy *OrderByClause
 	Limit   *LimitClause
+	Unions  []*UnionPart // queries combined with this one by UNION [ALL]
+	Mode    string       // "explain" or "profile" for an EXPLAIN or PROFILE prefix
+}
+
+// UnionPart is a query appended to the first one by UNION or UNION ALL
+type UnionPart struct {
+	All   bool // UNION ALL keeps duplicate rows
+	Query *Query
+}
+
+// WithStage is a pipeline stage: its MATCH clauses (possibly none) and the
+// WITH projection passed on to the next stage
+type WithStage struct {
+	Matches []*MatchClause
+	With    *WithClause
+}
+
+// WithClause represents WITH [DISTINCT] items [WHERE expr]
+type WithClause struct {
+	Items    []ReturnItem
+	Distinct bool
+	Where    *WhereClause // filters the projected rows
+}
+
+// CallClause represents CALL procedure(args) YIELD items
+type CallClause struct {
+	Procedure string // e.g. "chainsaw.search"
+	Args      []Expression
+	Yield     []YieldItem
+	Where     *WhereClause // YIELD ... WHERE, filters the yielded rows
 }
 
-// MatchClause represents the MATCH part
+// YieldItem is a column of a procedure bound to a variable
+type YieldItem struct {
+	Name  string
+	Alias string // AS alias (empty if none)
+}
+
+// MatchClause represents one MATCH with its comma-separated patterns.
+// Patterns across all MATCH clauses are joined on shared variables.
 type MatchClause struct {
-	Pattern *PathPattern
+	Patterns []*PathPattern
+	Where    *WhereClause // nil if no WHERE
+	Optional bool         // OPTIONAL MATCH: keep rows without a match
+}
+
+// WhereClause represents the WHERE part of a MATCH
+type WhereClause struct {
+	Expr Expression
+}
+
+// Expression is implemented by all WHERE expression nodes
+type Expression interface {
+	expressionNode()
+}
+
+// BinaryExpr represents AND / OR
+type BinaryExpr struct {
+	Op    string // "AND", "OR"
+	Left  Expression
+	Right Expression
 }
 
-// PathPattern represents a node-edge-node pattern
+// NotExpr represents NOT <expr>
+type NotExpr struct {
+	Expr Expression
+}
+
+// Comparison represents <left> <op> <right>
+type Comparison struct {
+	Op    string // "=", "<>", "<", ">", "<=", ">=", "IN", "STARTS WITH", "ENDS WITH", "CONTAINS", "=~"
+	Left  Expression
+	Right Expression
+}
+
+// IsNullExpr represents <expr> IS [NOT] NULL
+type IsNullExpr struct {
+	Expr    Expression
+	Negated bool
+}
+
+// PropertyRef represents var.property
+type PropertyRef struct {
+	Variable string
+	Property string
+}
+
+// VariableRef represents a bare variable
+type VariableRef struct {
+	Variable string
+}
+
+// FunctionCall represents a scalar function applied to a variable, e.g. type(r)
+type FunctionCall struct {
+	Name     string
+	Variable string
+	Argument Expression // second argument, e.g. the text of similar(f, "...")
+}
+
+// ExistsExpr represents a pattern predicate such as (f)<-[:calls]-() or
+// EXISTS { MATCH ... WHERE ... }. It holds when the patterns match with the
+// variables bound by the enclosing query.
+type ExistsExpr struct {
+	Patterns []*PathPattern
+	Where    *WhereClause
+}
+
+// Parameter represents a $name placeholder bound at transpile time
+type Parameter struct {
+	Name string
+}
+
+// Literal represents a string, number, boolean or null constant
+type Literal struct {
+	Value interface{} // string, int64, float64, bool or nil
+}
+
+// ListLiteral represents [a, b, c]
+type ListLiteral struct {
+	Items []Expression
+}
+
+func (*BinaryExpr) expressionNode()   {}
+func (*NotExpr) expressionNode()      {}
+func (*Comparison) expressionNode()   {}
+func (*IsNullExpr) expressionNode()   {}
+func (*PropertyRef) expressionNode()  {}
+func (*VariableRef) expressionNode()  {}
+func (*FunctionCall) expressionNode() {}
+func (*Parameter) expressionNode()    {}
+func (*ExistsExpr) expressionNode()   {}
+func (*Literal) expressionNode()      {}
+func (*ListLiteral) expressionNode()  {}
+
+// PathPattern represents a chain of nodes joined by edges:
+// Nodes[0] Edges[0] Nodes[1] ... Edges[n-1] Nodes[n]
 type PathPattern struct {
-	SourceNode *Node
-	Edge       *Edge
-	TargetNode *Node
+	Nodes    []*Node
+	Edges    []*Edge // len(Edges) == len(Nodes)-1
+	Variable string  // path variable of p = (...), empty if none
+	Shortest bool    // p = shortestPath(...)
 }
 
 // Node represents a node in the pattern
 type Node struct {
-	Variable string
-	Label    string // entity_type filter
+	Variable   string
+	Label      string           // entity_type filter
+	Properties []*PropertyEntry // inline map, e.g. {name: 'main'}
+}
+
+// PropertyEntry is one key: value pair of an inline node property map
+type PropertyEntry struct {
+	Key   string
+	Value Expression
 }
 
 // Edge represents an edge in the pattern
 type Edge struct {
-	Type      string // relation_type filter
-	Direction string // "->", "<-", "-"
-	MinHops   int    // Minimum hops for multi-hop (0 = not specified)
-	MaxHops   int    // Maximum hops for multi-hop (0 = not specified)
+	Variable  string   // relationship variable (empty if unnamed)
+	Types     []string // relation_type filter, any of (empty = any type)
+	Direction string   // "->", "<-", "-"
+	MinHops   int      // Minimum hops for multi-hop (0 = not specified)
+	MaxHops   int      // Maximum hops for multi-hop (0 = not specified)
 }
 
 // ReturnClause represents the RETURN part
 type ReturnClause struct {
-	Items []ReturnItem
+	Items    []ReturnItem
+	Distinct bool // RETURN DISTINCT
 }
 
 // ReturnItem represents what to return
 type ReturnItem struct {
 	Variable  string
 	Property  string             // empty if returning whole variable
+	Function  string             // scalar function applied to Variable, e.g. "type"
+	Argument  Expression         // second argument of Function, if any
 	Aggregate *AggregateFunction // non-nil if this is an aggregate
 	Alias     string             // AS alias (empty if none)
 }
 
 // AggregateFunction represents COUNT, SUM, etc.
 type AggregateFunction struct {
-	Function string // "COUNT", "SUM", "AVG", "MIN", "MAX"
-	Variable string // variable being aggregated
+	Function string // "COUNT", "SUM", "AVG", "MIN", "MAX", "COLLECT"
+	Variable string // variable being aggregated (empty for COUNT(*))
 	Property string // property being aggregated (empty for whole variable)
+	Distinct bool   // aggregate distinct values only, e.g. COUNT(DISTINCT n)
 }
 
 // GroupByClause represents GROUP BY
@@ -85,28 +231,26 @@ type OrderByItem struct {
 	Ascending  bool   // true for ASC, false for DESC
 }
 
-// LimitClause represents LIMIT
+// LimitClause represents [SKIP n] [LIMIT n], where n is an integer or a
+// $parameter
 type LimitClause struct {
-	Count int
+	Count      int // -1 if only SKIP was given
+	Skip       int
+	CountParam *Parameter // set when LIMIT takes a $parameter
+	SkipParam  *Parameter // set when SKIP takes a $parameter
 }
 
 // Constructor functions for gocc
 
-func NewQuery(match, ret Attrib) (*Query, error) {
-	return &Query{
-		Match:   match.(*MatchClause),
-		Return:  ret.(*ReturnClause),
-		GroupBy: nil,
-		OrderBy: nil,
-		Limit:   nil,
-	}, nil
+func NewQuery(body, ret Attrib) (*Query, error) {
+	q := body.(*Query)
+	q.Return = ret.(*ReturnClause)
+	return q, nil
 }
 
-func NewQueryWithClauses(match, ret, groupBy, orderBy, limit Attrib) (*Query, error) {
-	q := &Query{
-		Match:  match.(*MatchClause),
-		Return: ret.(*ReturnClause),
-	}
+func NewQueryWithClauses(body, ret, groupBy, orderBy, limit Attrib) (*Query, error) {
+	q := body.(*Query)
+	q.Return = ret.(*ReturnClause)
 
 	if groupBy != nil {
 		q.GroupBy = groupBy.(*GroupByClause)
@@ -121,20 +265,183 @@ func NewQueryWithClauses(match, ret, groupBy, orderBy, limit Attrib) (*Query, er
 	return q, nil
 }
 
-func NewMatchClause(pattern Attrib) (*MatchClause, error) {
-	return &MatchClause{
-		Pattern: pattern.(*PathPattern),
+// AppendUnion combines another query with the first one
+func AppendUnion(query, part Attrib, all bool) (*Query, error) {
+	q := query.(*Query)
+	q.Unions = append(q.Unions, &UnionPart{
+		All:   all,
+		Query: part.(*Query),
+	})
+	return q, nil
+}
+
+// SetMode records an EXPLAIN or PROFILE prefix on a query
+func SetMode(query Attrib, mode string) (*Query, error) {
+	q := query.(*Query)
+	q.Mode = mode
+	return q, nil
+}
+
+// NewQueryBody starts a query with its first MATCH clauses
+func NewQueryBody(matches Attrib) (*Query, error) {
+	return &Query{
+		Matches: matches.([]*MatchClause),
 	}, nil
 }
 
-func NewPathPattern(source, edge, target Attrib) (*PathPattern, error) {
+// NewCallBody starts a query with a CALL, optionally followed by MATCH
+// clauses
+func NewCallBody(call, matches Attrib) (*Query, error) {
+	q := &Query{Call: call.(*CallClause)}
+	if matches != nil {
+		q.Matches = matches.([]*MatchClause)
+	}
+	return q, nil
+}
+
+// NewCallQuery builds a query of a single CALL ... YIELD, which returns the
+// yielded columns
+func NewCallQuery(call Attrib) (*Query, error) {
+	return &Query{Call: call.(*CallClause)}, nil
+}
+
+// NewStandaloneCall builds a query of a single CALL without YIELD, which
+// returns every column of the procedure
+func NewStandaloneCall(namespaceTok, nameTok, args Attrib) (*Query, error) {
+	call, err := NewCallClause(namespaceTok, nameTok, args, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &Query{Call: call}, nil
+}
+
+// NewCallClause builds CALL namespace.name(args) YIELD items. args and
+// yield may be nil.
+func NewCallClause(namespaceTok, nameTok, args, yield Attrib) (*CallClause, error) {
+	call := &CallClause{
+		Procedure: string(namespaceTok.(*token.Token).Lit) + "." + string(nameTok.(*token.Token).Lit),
+	}
+	if args != nil {
+		call.Args = args.([]Expression)
+	}
+	if yield != nil {
+		call.Yield = yield.([]YieldItem)
+	}
+	return call, nil
+}
+
+// FilterCall builds CALL ... YIELD items WHERE expr
+func FilterCall(namespaceTok, nameTok, args, yield, where Attrib) (*CallClause, error) {
+	call, err := NewCallClause(namespaceTok, nameTok, args, yield)
+	if err != nil {
+		return nil, err
+	}
+	call.Where = where.(*WhereClause)
+	return call, nil
+}
+
+func NewYieldItems(item Attrib) ([]YieldItem, error) {
+	return []YieldItem{item.(YieldItem)}, nil
+}
+
+func AppendYieldItem(list, item Attrib) ([]YieldItem, error) {
+	return append(list.([]YieldItem), item.(YieldItem)), nil
+}
+
+// NewYieldItem builds a YIELD column with an optional AS alias
+func NewYieldItem(nameTok, aliasTok Attrib) (YieldItem, error) {
+	item := YieldItem{Name: string(nameTok.(*token.Token).Lit)}
+	if aliasTok != nil {
+		item.Alias = string(aliasTok.(*token.Token).Lit)
+	}
+	return item, nil
+}
+
+// AppendWithStage closes the current MATCH clauses with a WITH and starts
+// the next stage with matches, which may be nil
+func AppendWithStage(body, with, matches Attrib) (*Query, error) {
+	q := body.(*Query)
+	q.Stages = append(q.Stages, &WithStage{
+		Matches: q.Matches,
+		With:    with.(*WithClause),
+	})
+	q.Matches = nil
+	if matches != nil {
+		q.Matches = matches.([]*MatchClause)
+	}
+	return q, nil
+}
+
+func NewWithClause(items, where Attrib, distinct bool) (*WithClause, error) {
+	w := &WithClause{
+		Items:    items.([]ReturnItem),
+		Distinct: distinct,
+	}
+	if where != nil {
+		w.Where = where.(*WhereClause)
+	}
+	return w, nil
+}
+
+func NewMatchClauses(match Attrib) ([]*MatchClause, error) {
+	return []*MatchClause{match.(*MatchClause)}, nil
+}
+
+func AppendMatchClause(list, match Attrib) ([]*MatchClause, error) {
+	matches := list.([]*MatchClause)
+	return append(matches, match.(*MatchClause)), nil
+}
+
+func NewMatchClause(patterns, where Attrib) (*MatchClause, error) {
+	m := &MatchClause{
+		Patterns: patterns.([]*PathPattern),
+	}
+	if where != nil {
+		m.Where = where.(*WhereClause)
+	}
+	return m, nil
+}
+
+func NewOptionalMatchClause(patterns, where Attrib) (*MatchClause, error) {
+	m, err := NewMatchClause(patterns, where)
+	if err != nil {
+		return nil, err
+	}
+	m.Optional = true
+	return m, nil
+}
+
+func NewPatternList(pattern Attrib) ([]*PathPattern, error) {
+	return []*PathPattern{pattern.(*PathPattern)}, nil
+}
+
+func AppendPattern(list, pattern Attrib) ([]*PathPattern, error) {
+	patterns := list.([]*PathPattern)
+	return append(patterns, pattern.(*PathPattern)), nil
+}
+
+// NamePath binds a path pattern to a path variable, optionally asking for
+// the shortest path only
+func NamePath(varTok, pattern Attrib, shortest bool) (*PathPattern, error) {
+	p := pattern.(*PathPattern)
+	p.Variable = string(varTok.(*token.Token).Lit)
+	p.Shortest = shortest
+	return p, nil
+}
+
+func NewPathPattern(node Attrib) (*PathPattern, error) {
 	return &PathPattern{
-		SourceNode: source.(*Node),
-		Edge:       edge.(*Edge),
-		TargetNode: target.(*Node),
+		Nodes: []*Node{node.(*Node)},
 	}, nil
 }
 
+func AppendPathSegment(pattern, edge, node Attrib) (*PathPattern, error) {
+	p := pattern.(*PathPattern)
+	p.Edges = append(p.Edges, edge.(*Edge))
+	p.Nodes = append(p.Nodes, node.(*Node))
+	return p, nil
+}
+
 func NewNodeLabeled(varTok, labelTok Attrib) (*Node, error) {
 	return &Node{
 		Variable: string(varTok.(*token.Token).Lit),
@@ -156,18 +463,71 @@ func NewNodeAnon() (*Node, error) {
 	}, nil
 }
 
-func NewEdgeForward(typeTok Attrib) (*Edge, error) {
+func NewNodeLabeledWithProperties(varTok, labelTok, props Attrib) (*Node, error) {
+	node, _ := NewNodeLabeled(varTok, labelTok)
+	node.Properties = props.([]*PropertyEntry)
+	return node, nil
+}
+
+func NewNodeVarWithProperties(varTok, props Attrib) (*Node, error) {
+	node, _ := NewNodeVar(varTok)
+	node.Properties = props.([]*PropertyEntry)
+	return node, nil
+}
+
+func NewNodeLabeledAnon(labelTok, props Attrib) (*Node, error) {
+	node := &Node{
+		Label: string(labelTok.(*token.Token).Lit),
+	}
+	if props != nil {
+		node.Properties = props.([]*PropertyEntry)
+	}
+	return node, nil
+}
+
+func NewNodeAnonWithProperties(props Attrib) (*Node, error) {
+	node, _ := NewNodeAnon()
+	node.Properties = props.([]*PropertyEntry)
+	return node, nil
+}
+
+func NewPropertyEntries(entry Attrib) ([]*PropertyEntry, error) {
+	return []*PropertyEntry{entry.(*PropertyEntry)}, nil
+}
+
+func AppendPropertyEntry(list, entry Attrib) ([]*PropertyEntry, error) {
+	entries := list.([]*PropertyEntry)
+	return append(entries, entry.(*PropertyEntry)), nil
+}
+
+func NewPropertyEntry(keyTok, value Attrib) (*PropertyEntry, error) {
+	return &PropertyEntry{
+		Key:   string(keyTok.(*token.Token).Lit),
+		Value: value.(Expression),
+	}, nil
+}
+
+func NewRelTypes(typeTok Attrib) ([]string, error) {
+	return []string{string(typeTok.(*token.Token).Lit)}, nil
+}
+
+func AppendRelType(list, typeTok Attrib) ([]string, error) {
+	types := list.([]string)
+	return append(types, string(typeTok.(*token.Token).Lit)), nil
+}
+
+func NewEdgeForward(types Attrib) (*Edge, error) {
 	return &Edge{
-		Type:      string(typeTok.(*token.Token).Lit),
+		Types:     types.([]string),
 		Direction: "->",
 		MinHops:   0,
 		MaxHops:   0,
 	}, nil
 }
 
-func NewEdgeBackward(typeTok Attrib) (*Edge, error) {
+func NewEdgeBackward(types Attrib) (*Edge, error) {
 	return &Edge{
-		Type:      string(typeTok.(*token.Token).Lit),
+		Types:     types.([]string),
 		Direction: "<-",
 		MinHops:   0,
 		MaxHops:   0,
@@ -176,7 +536,7 @@ func NewEdgeBackward(typeTok Attrib) (*Edge, error) {
 
 func NewEdgeAnyForward() (*Edge, error) {
 	return &Edge{
-		Type:      "",
+		Types:     nil,
 		Direction: "->",
 		MinHops:   0,
 		MaxHops:   0,
@@ -185,14 +545,66 @@ func NewEdgeAnyForward() (*Edge, error) {
 
 func NewEdgeAny() (*Edge, error) {
 	return &Edge{
-		Type:      "",
+		Types:     nil,
+		Direction: "-",
+		MinHops:   0,
+		MaxHops:   0,
+	}, nil
+}
+
+func NewEdgeUndirected(types Attrib) (*Edge, error) {
+	return &Edge{
+		Types:     types.([]string),
 		Direction: "-",
 		MinHops:   0,
 		MaxHops:   0,
 	}, nil
 }
 
-func NewEdgeMultiHopForward(typeTok, minTok, maxTok Attrib) (*Edge, error) {
+func NewNamedEdge(direction string, varTok, types Attrib) (*Edge, error) {
+	var typeList []string
+	if types != nil {
+		typeList = types.([]string)
+	}
+	return &Edge{
+		Variable:  string(varTok.(*token.Token).Lit),
+		Types:     typeList,
+		Direction: direction,
+	}, nil
+}
+
+// NewHops parses the *min..max of a named variable-length relation into an
+// edge that NewNamedMultiHopEdge completes
+func NewHops(minTok, maxTok Attrib) (*Edge, error) {
+	return newMultiHopEdge("", nil, minTok, maxTok), nil
+}
+
+// NewNamedMultiHopEdge builds a variable-length edge bound to a variable,
+// e.g. -[r:calls*1..3]->
+func NewNamedMultiHopEdge(direction string, varTok, types, hops Attrib) (*Edge, error) {
+	e := hops.(*Edge)
+	e.Direction = direction
+	e.Variable = string(varTok.(*token.Token).Lit)
+	if types != nil {
+		e.Types = types.([]string)
+	}
+	return e, nil
+}
+
+func NewEdgeMultiHopForward(types, minTok, maxTok Attrib) (*Edge, error) {
+	return newMultiHopEdge("->", types, minTok, maxTok), nil
+}
+
+func NewEdgeMultiHopBackward(types, minTok, maxTok Attrib) (*Edge, error) {
+	return newMultiHopEdge("<-", types, minTok, maxTok), nil
+}
+
+func NewEdgeMultiHopUndirected(types, minTok, maxTok Attrib) (*Edge, error) {
+	return newMultiHopEdge("-", types, minTok, maxTok), nil
+}
+
+// newMultiHopEdge builds a variable-length edge; types may be nil for any type
+func newMultiHopEdge(direction string, types, minTok, maxTok Attrib) *Edge {
 	minHops := 1
 	maxHops := 10 // Default max
 
@@ -208,58 +620,212 @@ func NewEdgeMultiHopForward(typeTok, minTok, maxTok Attrib) (*Edge, error) {
 		}
 	}
 
-	typeStr := ""
-	if typeTok != nil {
-		if tok, ok := typeTok.(*token.Token); ok {
-			typeStr = string(tok.Lit)
-		}
+	var typeList []string
+	if types != nil {
+		typeList = types.([]string)
 	}
 
 	return &Edge{
-		Type:      typeStr,
-		Direction: "->",
+		Types:     typeList,
+		Direction: direction,
 		MinHops:   minHops,
 		MaxHops:   maxHops,
+	}
+}
+
+// Where constructors
+
+func NewWhereClause(expr Attrib) (*WhereClause, error) {
+	return &WhereClause{
+		Expr: expr.(Expression),
 	}, nil
 }
 
-func NewEdgeMultiHopBackward(typeTok, minTok, maxTok Attrib) (*Edge, error) {
-	minHops := 1
-	maxHops := 10
+func NewBinaryExpr(op string, left, right Attrib) (Expression, error) {
+	return &BinaryExpr{
+		Op:    op,
+		Left:  left.(Expression),
+		Right: right.(Expression),
+	}, nil
+}
 
-	if minTok != nil {
-		if tok, ok := minTok.(*token.Token); ok {
-			fmt.Sscanf(string(tok.Lit), "%d", &minHops)
-		}
+func NewNotExpr(expr Attrib) (Expression, error) {
+	return &NotExpr{
+		Expr: expr.(Expression),
+	}, nil
+}
+
+func NewComparison(opTok, left, right Attrib) (Expression, error) {
+	return &Comparison{
+		Op:    string(opTok.(*token.Token).Lit),
+		Left:  left.(Expression),
+		Right: right.(Expression),
+	}, nil
+}
+
+func NewStringComparison(op string, left, right Attrib) (Expression, error) {
+	return &Comparison{
+		Op:    op,
+		Left:  left.(Expression),
+		Right: right.(Expression),
+	}, nil
+}
+
+func NewIsNull(expr Attrib, negated bool) (Expression, error) {
+	return &IsNullExpr{
+		Expr:    expr.(Expression),
+		Negated: negated,
+	}, nil
+}
+
+func NewPropertyRef(varTok, propTok Attrib) (Expression, error) {
+	return &PropertyRef{
+		Variable: string(varTok.(*token.Token).Lit),
+		Property: string(propTok.(*token.Token).Lit),
+	}, nil
+}
+
+func NewVariableRef(varTok Attrib) (Expression, error) {
+	return &VariableRef{
+		Variable: string(varTok.(*token.Token).Lit),
+	}, nil
+}
+
+func NewFunctionCall(nameTok, varTok Attrib) (Expression, error) {
+	return &FunctionCall{
+		Name:     string(nameTok.(*token.Token).Lit),
+		Variable: string(varTok.(*token.Token).Lit),
+	}, nil
+}
+
+// NewFunctionCallWithArg builds a function of a variable and a value, such
+// as similar(f, "retry with backoff")
+func NewFunctionCallWithArg(nameTok, varTok, arg Attrib) (Expression, error) {
+	return &FunctionCall{
+		Name:     string(nameTok.(*token.Token).Lit),
+		Variable: string(varTok.(*token.Token).Lit),
+		Argument: arg.(Expression),
+	}, nil
+}
+
+func NewListLiteral(items Attrib) (Expression, error) {
+	if items == nil {
+		return &ListLiteral{}, nil
 	}
+	return &ListLiteral{
+		Items: items.([]Expression),
+	}, nil
+}
 
-	if maxTok != nil {
-		if tok, ok := maxTok.(*token.Token); ok {
-			fmt.Sscanf(string(tok.Lit), "%d", &maxHops)
-		}
+func NewValueList(item Attrib) ([]Expression, error) {
+	return []Expression{item.(Expression)}, nil
+}
+
+func AppendValue(list, item Attrib) ([]Expression, error) {
+	items := list.([]Expression)
+	return append(items, item.(Expression)), nil
+}
+
+func NewStringLiteral(strTok Attrib) (Expression, error) {
+	lit := string(strTok.(*token.Token).Lit)
+	return &Literal{
+		Value: unescapeString(lit[1 : len(lit)-1]),
+	}, nil
+}
+
+func NewIntLiteral(intTok Attrib, negative bool) (Expression, error) {
+	v, err := strconv.ParseInt(string(intTok.(*token.Token).Lit), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid integer literal: %w", err)
+	}
+	if negative {
+		v = -v
 	}
+	return &Literal{Value: v}, nil
+}
 
-	typeStr := ""
-	if typeTok != nil {
-		if tok, ok := typeTok.(*token.Token); ok {
-			typeStr = string(tok.Lit)
-		}
+func NewFloatLiteral(intTok, fracTok Attrib, negative bool) (Expression, error) {
+	text := string(intTok.(*token.Token).Lit) + "." + string(fracTok.(*token.Token).Lit)
+	v, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid float literal: %w", err)
 	}
+	if negative {
+		v = -v
+	}
+	return &Literal{Value: v}, nil
+}
 
-	return &Edge{
-		Type:      typeStr,
-		Direction: "<-",
-		MinHops:   minHops,
-		MaxHops:   maxHops,
+func NewPatternPredicate(pattern, edge, node Attrib) (Expression, error) {
+	p, _ := AppendPathSegment(pattern, edge, node)
+	return &ExistsExpr{
+		Patterns: []*PathPattern{p},
+	}, nil
+}
+
+func NewExistsExpr(patterns, where Attrib) (Expression, error) {
+	e := &ExistsExpr{
+		Patterns: patterns.([]*PathPattern),
+	}
+	if where != nil {
+		e.Where = where.(*WhereClause)
+	}
+	return e, nil
+}
+
+func NewParameter(paramTok Attrib) (Expression, error) {
+	return &Parameter{
+		Name: string(paramTok.(*token.Token).Lit)[1:],
 	}, nil
 }
 
+func NewBoolLiteral(v bool) (Expression, error) {
+	return &Literal{Value: v}, nil
+}
+
+func NewNullLiteral() (Expression, error) {
+	return &Literal{Value: nil}, nil
+}
+
+// unescapeString resolves backslash escapes in a quoted string literal body
+func unescapeString(s string) string {
+	var b []byte
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' || i+1 == len(s) {
+			b = append(b, s[i])
+			continue
+		}
+		i++
+		switch s[i] {
+		case 'n':
+			b = append(b, '\n')
+		case 't':
+			b = append(b, '\t')
+		case 'r':
+			b = append(b, '\r')
+		case '\\', '\'', '"':
+			b = append(b, s[i])
+		default:
+			// Unknown escapes such as \. are kept for regular expressions
+			b = append(b, '\\', s[i])
+		}
+	}
+	return string(b)
+}
+
 func NewReturnClause(items Attrib) (*ReturnClause, error) {
 	return &ReturnClause{
 		Items: items.([]ReturnItem),
 	}, nil
 }
 
+func NewReturnClauseDistinct(items Attrib) (*ReturnClause, error) {
+	return &ReturnClause{
+		Items:    items.([]ReturnItem),
+		Distinct: true,
+	}, nil
+}
+
 func NewReturnItems(item Attrib) ([]ReturnItem, error) {
 	return []ReturnItem{item.(ReturnItem)}, nil
 }
@@ -296,30 +862,78 @@ func NewReturnPropWithAlias(varTok, propTok, aliasTok Attrib) (ReturnItem, error
 	}, nil
 }
 
-func NewReturnAggregate(funcTok, varTok Attrib) (ReturnItem, error) {
-	return ReturnItem{
-		Variable: "",
-		Property: "",
-		Aggregate: &AggregateFunction{
-			Function: string(funcTok.(*token.Token).Lit),
-			Variable: string(varTok.(*token.Token).Lit),
-			Property: "",
-		},
-		Alias: "",
-	}, nil
+// aggregateFunctions lists the functions that aggregate over rows
+var aggregateFunctions = map[string]bool{
+	"COUNT": true, "SUM": true, "AVG": true, "MIN": true, "MAX": true, "COLLECT": true,
 }
 
-func NewReturnAggregateWithAlias(funcTok, varTok, aliasTok Attrib) (ReturnItem, error) {
-	return ReturnItem{
-		Variable: "",
-		Property: "",
-		Aggregate: &AggregateFunction{
-			Function: string(funcTok.(*token.Token).Lit),
-			Variable: string(varTok.(*token.Token).Lit),
-			Property: "",
-		},
-		Alias: string(aliasTok.(*token.Token).Lit),
-	}, nil
+// NewReturnCall builds a function call RETURN item such as COUNT(*),
+// SUM(n.weight) or type(r). varTok is nil for *, propTok and aliasTok may be
+// nil. Aggregate names are case-insensitive.
+func NewReturnCall(funcTok, varTok, propTok, aliasTok Attrib) (ReturnItem, error) {
+	name := string(funcTok.(*token.Token).Lit)
+	var item ReturnItem
+	if aliasTok != nil {
+		item.Alias = string(aliasTok.(*token.Token).Lit)
+	}
+	variable, property := "", ""
+	if varTok != nil {
+		variable = string(varTok.(*token.Token).Lit)
+	}
+	if propTok != nil {
+		property = string(propTok.(*token.Token).Lit)
+	}
+
+	if fn := strings.ToUpper(name); aggregateFunctions[fn] {
+		if variable == "" && fn != "COUNT" {
+			return item, fmt.Errorf("%s(*) is not supported, only COUNT(*)", fn)
+		}
+		item.Aggregate = &AggregateFunction{
+			Function: fn,
+			Variable: variable,
+			Property: property,
+		}
+		return item, nil
+	}
+
+	if variable == "" || property != "" {
+		return item, fmt.Errorf("%s() takes a single variable", name)
+	}
+	item.Variable = variable
+	item.Function = name
+	return item, nil
+}
+
+// NewReturnDistinctCall builds an aggregate over distinct values such as
+// COUNT(DISTINCT n) or COLLECT(DISTINCT n.file)
+func NewReturnDistinctCall(funcTok, varTok, propTok, aliasTok Attrib) (ReturnItem, error) {
+	item, err := NewReturnCall(funcTok, varTok, propTok, aliasTok)
+	if err != nil {
+		return item, err
+	}
+	if item.Aggregate == nil {
+		return item, fmt.Errorf("DISTINCT is only allowed in aggregates, e.g. COUNT(DISTINCT %s)", item.Variable)
+	}
+	item.Aggregate.Distinct = true
+	return item, nil
+}
+
+// NewReturnCallWithArg builds a RETURN item calling a function of a
+// variable and a value, such as similar(f, "retry with backoff")
+func NewReturnCallWithArg(funcTok, varTok, arg, aliasTok Attrib) (ReturnItem, error) {
+	name := string(funcTok.(*token.Token).Lit)
+	if aggregateFunctions[strings.ToUpper(name)] {
+		return ReturnItem{}, fmt.Errorf("%s() takes a single argument", name)
+	}
+	item := ReturnItem{
+		Variable: string(varTok.(*token.Token).Lit),
+		Function: name,
+		Argument: arg.(Expression),
+	}
+	if aliasTok != nil {
+		item.Alias = string(aliasTok.(*token.Token).Lit)
+	}
+	return item, nil
 }
 
 // GroupBy constructors
@@ -386,10 +1000,27 @@ func NewOrderByItem(exprTok Attrib) (OrderByItem, error) {
 
 // Limit constructor
 
-func NewLimitClause(countTok Attrib) (*LimitClause, error) {
-	var count int
-	fmt.Sscanf(string(countTok.(*token.Token).Lit), "%d", &count)
-	return &LimitClause{
-		Count: count,
-	}, nil
+func NewLimitClause(count Attrib) (*LimitClause, error) {
+	limit := &LimitClause{}
+	limit.Count, limit.CountParam = limitValue(count)
+	return limit, nil
+}
+
+func NewSkipLimitClause(skip, count Attrib) (*LimitClause, error) {
+	limit := &LimitClause{Count: -1}
+	limit.Skip, limit.SkipParam = limitValue(skip)
+	if count != nil {
+		limit.Count, limit.CountParam = limitValue(count)
+	}
+	return limit, nil
+}
+
+// limitValue reads an integer token, or returns the $parameter in its place
+func limitValue(value Attrib) (int, *Parameter) {
+	if param, ok := value.(*Parameter); ok {
+		return 0, param
+	}
+	var n int
+	fmt.Sscanf(string(value.(*token.Token).Lit), "%d", &n)
+	return n, nil
 }
@@ -11,8 +11,8 @@ import (
 
 const (
 	NoState    = -1
-	NumStates  = 54
-	NumSymbols = 68
+	NumStates  = 189
+	NumSymbols = 232
 )
 
 type Lexer struct {
@@ -131,70 +131,234 @@ func (l *Lexer) Reset() {
 Lexer symbols:
 0: '_'
 1: '_'
-2: 'M'
-3: 'A'
-4: 'T'
-5: 'C'
-6: 'H'
-7: '('
-8: ':'
-9: ')'
-10: '-'
-11: '['
-12: ']'
-13: '>'
-14: '*'
-15: '.'
-16: '<'
-17: 'R'
-18: 'E'
-19: 'T'
-20: 'U'
-21: 'R'
-22: 'N'
-23: ','
-24: 'A'
-25: 'S'
-26: 'G'
-27: 'R'
+2: '$'
+3: '_'
+4: '_'
+5: '\''
+6: '\''
+7: '"'
+8: '"'
+9: '\\'
+10: '\\'
+11: 'E'
+12: 'X'
+13: 'P'
+14: 'L'
+15: 'A'
+16: 'I'
+17: 'N'
+18: 'P'
+19: 'R'
+20: 'O'
+21: 'F'
+22: 'I'
+23: 'L'
+24: 'E'
+25: 'U'
+26: 'N'
+27: 'I'
 28: 'O'
-29: 'U'
-30: 'P'
-31: 'B'
-32: 'Y'
-33: 'O'
-34: 'R'
-35: 'D'
-36: 'E'
-37: 'R'
-38: 'A'
-39: 'S'
-40: 'C'
-41: 'D'
+29: 'N'
+30: 'A'
+31: 'L'
+32: 'L'
+33: 'C'
+34: 'A'
+35: 'L'
+36: 'L'
+37: '.'
+38: '('
+39: ')'
+40: 'Y'
+41: 'I'
 42: 'E'
-43: 'S'
-44: 'C'
-45: 'L'
-46: 'I'
-47: 'M'
-48: 'I'
-49: 'T'
-50: ' '
-51: '\t'
-52: '\n'
-53: '\r'
-54: '/'
-55: '/'
-56: '\n'
-57: 'a'-'z'
-58: 'a'-'z'
-59: 'A'-'Z'
-60: '0'-'9'
-61: 'A'-'Z'
-62: 'a'-'z'
-63: 'A'-'Z'
-64: '0'-'9'
-65: '0'-'9'
-66: '0'-'9'
-67: .
+43: 'L'
+44: 'D'
+45: ','
+46: 'A'
+47: 'S'
+48: 'W'
+49: 'I'
+50: 'T'
+51: 'H'
+52: 'D'
+53: 'I'
+54: 'S'
+55: 'T'
+56: 'I'
+57: 'N'
+58: 'C'
+59: 'T'
+60: 'M'
+61: 'A'
+62: 'T'
+63: 'C'
+64: 'H'
+65: 'O'
+66: 'P'
+67: 'T'
+68: 'I'
+69: 'O'
+70: 'N'
+71: 'A'
+72: 'L'
+73: '='
+74: 's'
+75: 'h'
+76: 'o'
+77: 'r'
+78: 't'
+79: 'e'
+80: 's'
+81: 't'
+82: 'P'
+83: 'a'
+84: 't'
+85: 'h'
+86: ':'
+87: '*'
+88: '{'
+89: '}'
+90: '-'
+91: '['
+92: ']'
+93: '>'
+94: '<'
+95: '|'
+96: 'W'
+97: 'H'
+98: 'E'
+99: 'R'
+100: 'E'
+101: 'O'
+102: 'R'
+103: 'A'
+104: 'N'
+105: 'D'
+106: 'N'
+107: 'O'
+108: 'T'
+109: 'I'
+110: 'N'
+111: 'S'
+112: 'T'
+113: 'A'
+114: 'R'
+115: 'T'
+116: 'S'
+117: 'E'
+118: 'N'
+119: 'D'
+120: 'S'
+121: 'C'
+122: 'O'
+123: 'N'
+124: 'T'
+125: 'A'
+126: 'I'
+127: 'N'
+128: 'S'
+129: '='
+130: '~'
+131: 'I'
+132: 'S'
+133: 'N'
+134: 'U'
+135: 'L'
+136: 'L'
+137: 'E'
+138: 'X'
+139: 'I'
+140: 'S'
+141: 'T'
+142: 'S'
+143: '<'
+144: '>'
+145: '<'
+146: '='
+147: '>'
+148: '='
+149: 'T'
+150: 'R'
+151: 'U'
+152: 'E'
+153: 't'
+154: 'r'
+155: 'u'
+156: 'e'
+157: 'F'
+158: 'A'
+159: 'L'
+160: 'S'
+161: 'E'
+162: 'f'
+163: 'a'
+164: 'l'
+165: 's'
+166: 'e'
+167: 'n'
+168: 'u'
+169: 'l'
+170: 'l'
+171: 'R'
+172: 'E'
+173: 'T'
+174: 'U'
+175: 'R'
+176: 'N'
+177: 'G'
+178: 'R'
+179: 'O'
+180: 'U'
+181: 'P'
+182: 'B'
+183: 'Y'
+184: 'O'
+185: 'R'
+186: 'D'
+187: 'E'
+188: 'R'
+189: 'A'
+190: 'S'
+191: 'C'
+192: 'D'
+193: 'E'
+194: 'S'
+195: 'C'
+196: 'L'
+197: 'I'
+198: 'M'
+199: 'I'
+200: 'T'
+201: 'S'
+202: 'K'
+203: 'I'
+204: 'P'
+205: ' '
+206: '\t'
+207: '\n'
+208: '\r'
+209: '/'
+210: '/'
+211: '\n'
+212: 'a'-'z'
+213: 'a'-'z'
+214: 'A'-'Z'
+215: '0'-'9'
+216: 'A'-'Z'
+217: 'a'-'z'
+218: 'A'-'Z'
+219: '0'-'9'
+220: '0'-'9'
+221: '0'-'9'
+222: 'a'-'z'
+223: 'A'-'Z'
+224: 'a'-'z'
+225: 'A'-'Z'
+226: '0'-'9'
+227: .
+228: .
+229: .
+230: .
+231: .
 */
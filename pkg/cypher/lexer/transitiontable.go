@@ -21,62 +21,112 @@ var TransTab = TransitionTable{
 			return 1
 		case r == 32: // [' ',' ']
 			return 1
-		case r == 40: // ['(','(']
+		case r == 34: // ['"','"']
 			return 2
-		case r == 41: // [')',')']
+		case r == 36: // ['$','$']
 			return 3
-		case r == 42: // ['*','*']
+		case r == 39: // ['\'','\'']
 			return 4
-		case r == 44: // [',',',']
+		case r == 40: // ['(','(']
 			return 5
-		case r == 45: // ['-','-']
+		case r == 41: // [')',')']
 			return 6
-		case r == 46: // ['.','.']
+		case r == 42: // ['*','*']
 			return 7
-		case r == 47: // ['/','/']
+		case r == 44: // [',',',']
 			return 8
-		case 48 <= r && r <= 57: // ['0','9']
+		case r == 45: // ['-','-']
 			return 9
-		case r == 58: // [':',':']
+		case r == 46: // ['.','.']
 			return 10
-		case r == 60: // ['<','<']
+		case r == 47: // ['/','/']
 			return 11
-		case r == 62: // ['>','>']
+		case 48 <= r && r <= 57: // ['0','9']
 			return 12
-		case r == 65: // ['A','A']
+		case r == 58: // [':',':']
 			return 13
-		case r == 66: // ['B','B']
+		case r == 60: // ['<','<']
 			return 14
-		case r == 67: // ['C','C']
+		case r == 61: // ['=','=']
 			return 15
-		case r == 68: // ['D','D']
+		case r == 62: // ['>','>']
 			return 16
-		case 69 <= r && r <= 70: // ['E','F']
-			return 15
-		case r == 71: // ['G','G']
+		case r == 65: // ['A','A']
 			return 17
-		case 72 <= r && r <= 75: // ['H','K']
-			return 15
-		case r == 76: // ['L','L']
+		case r == 66: // ['B','B']
 			return 18
-		case r == 77: // ['M','M']
+		case r == 67: // ['C','C']
 			return 19
-		case r == 78: // ['N','N']
-			return 15
-		case r == 79: // ['O','O']
+		case r == 68: // ['D','D']
 			return 20
-		case 80 <= r && r <= 81: // ['P','Q']
-			return 15
-		case r == 82: // ['R','R']
+		case r == 69: // ['E','E']
 			return 21
-		case 83 <= r && r <= 90: // ['S','Z']
-			return 15
-		case r == 91: // ['[','[']
+		case r == 70: // ['F','F']
 			return 22
-		case r == 93: // [']',']']
+		case r == 71: // ['G','G']
 			return 23
-		case 97 <= r && r <= 122: // ['a','z']
+		case r == 72: // ['H','H']
+			return 24
+		case r == 73: // ['I','I']
+			return 25
+		case 74 <= r && r <= 75: // ['J','K']
+			return 24
+		case r == 76: // ['L','L']
+			return 26
+		case r == 77: // ['M','M']
+			return 27
+		case r == 78: // ['N','N']
+			return 28
+		case r == 79: // ['O','O']
+			return 29
+		case r == 80: // ['P','P']
+			return 30
+		case r == 81: // ['Q','Q']
+			return 24
+		case r == 82: // ['R','R']
+			return 31
+		case r == 83: // ['S','S']
+			return 32
+		case r == 84: // ['T','T']
+			return 33
+		case r == 85: // ['U','U']
+			return 34
+		case r == 86: // ['V','V']
+			return 24
+		case r == 87: // ['W','W']
+			return 35
+		case r == 88: // ['X','X']
+			return 24
+		case r == 89: // ['Y','Y']
+			return 36
+		case r == 90: // ['Z','Z']
 			return 24
+		case r == 91: // ['[','[']
+			return 37
+		case r == 93: // [']',']']
+			return 38
+		case 97 <= r && r <= 101: // ['a','e']
+			return 39
+		case r == 102: // ['f','f']
+			return 40
+		case 103 <= r && r <= 109: // ['g','m']
+			return 39
+		case r == 110: // ['n','n']
+			return 41
+		case 111 <= r && r <= 114: // ['o','r']
+			return 39
+		case r == 115: // ['s','s']
+			return 42
+		case r == 116: // ['t','t']
+			return 43
+		case 117 <= r && r <= 122: // ['u','z']
+			return 39
+		case r == 123: // ['{','{']
+			return 44
+		case r == 124: // ['|','|']
+			return 45
+		case r == 125: // ['}','}']
+			return 46
 		}
 		return NoState
 	},
@@ -89,20 +139,36 @@ var TransTab = TransitionTable{
 	// S2
 	func(r rune) int {
 		switch {
+		case r == 34: // ['"','"']
+			return 47
+		case r == 92: // ['\\','\\']
+			return 48
+		default:
+			return 2
 		}
-		return NoState
 	},
 	// S3
 	func(r rune) int {
 		switch {
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 49
+		case r == 95: // ['_','_']
+			return 49
+		case 97 <= r && r <= 122: // ['a','z']
+			return 49
 		}
 		return NoState
 	},
 	// S4
 	func(r rune) int {
 		switch {
+		case r == 39: // ['\'','\'']
+			return 47
+		case r == 92: // ['\\','\\']
+			return 50
+		default:
+			return 4
 		}
-		return NoState
 	},
 	// S5
 	func(r rune) int {
@@ -125,16 +191,12 @@ var TransTab = TransitionTable{
 	// S8
 	func(r rune) int {
 		switch {
-		case r == 47: // ['/','/']
-			return 25
 		}
 		return NoState
 	},
 	// S9
 	func(r rune) int {
 		switch {
-		case 48 <= r && r <= 57: // ['0','9']
-			return 9
 		}
 		return NoState
 	},
@@ -147,80 +209,48 @@ var TransTab = TransitionTable{
 	// S11
 	func(r rune) int {
 		switch {
+		case r == 47: // ['/','/']
+			return 51
 		}
 		return NoState
 	},
 	// S12
 	func(r rune) int {
 		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 12
 		}
 		return NoState
 	},
 	// S13
 	func(r rune) int {
 		switch {
-		case 48 <= r && r <= 57: // ['0','9']
-			return 15
-		case 65 <= r && r <= 82: // ['A','R']
-			return 15
-		case r == 83: // ['S','S']
-			return 26
-		case 84 <= r && r <= 90: // ['T','Z']
-			return 15
-		case r == 95: // ['_','_']
-			return 15
-		case 97 <= r && r <= 122: // ['a','z']
-			return 15
 		}
 		return NoState
 	},
 	// S14
 	func(r rune) int {
 		switch {
-		case 48 <= r && r <= 57: // ['0','9']
-			return 15
-		case 65 <= r && r <= 88: // ['A','X']
-			return 15
-		case r == 89: // ['Y','Y']
-			return 27
-		case r == 90: // ['Z','Z']
-			return 15
-		case r == 95: // ['_','_']
-			return 15
-		case 97 <= r && r <= 122: // ['a','z']
-			return 15
+		case r == 61: // ['=','=']
+			return 52
+		case r == 62: // ['>','>']
+			return 53
 		}
 		return NoState
 	},
 	// S15
 	func(r rune) int {
 		switch {
-		case 48 <= r && r <= 57: // ['0','9']
-			return 15
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 15
-		case r == 95: // ['_','_']
-			return 15
-		case 97 <= r && r <= 122: // ['a','z']
-			return 15
+		case r == 126: // ['~','~']
+			return 54
 		}
 		return NoState
 	},
 	// S16
 	func(r rune) int {
 		switch {
-		case 48 <= r && r <= 57: // ['0','9']
-			return 15
-		case 65 <= r && r <= 68: // ['A','D']
-			return 15
-		case r == 69: // ['E','E']
-			return 28
-		case 70 <= r && r <= 90: // ['F','Z']
-			return 15
-		case r == 95: // ['_','_']
-			return 15
-		case 97 <= r && r <= 122: // ['a','z']
-			return 15
+		case r == 61: // ['=','=']
+			return 55
 		}
 		return NoState
 	},
@@ -228,17 +258,25 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 15
-		case 65 <= r && r <= 81: // ['A','Q']
-			return 15
-		case r == 82: // ['R','R']
-			return 29
-		case 83 <= r && r <= 90: // ['S','Z']
-			return 15
+			return 24
+		case 65 <= r && r <= 75: // ['A','K']
+			return 24
+		case r == 76: // ['L','L']
+			return 56
+		case r == 77: // ['M','M']
+			return 24
+		case r == 78: // ['N','N']
+			return 57
+		case 79 <= r && r <= 82: // ['O','R']
+			return 24
+		case r == 83: // ['S','S']
+			return 58
+		case 84 <= r && r <= 90: // ['T','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 15
+			return 24
 		case 97 <= r && r <= 122: // ['a','z']
-			return 15
+			return 24
 		}
 		return NoState
 	},
@@ -246,17 +284,17 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 15
-		case 65 <= r && r <= 72: // ['A','H']
-			return 15
-		case r == 73: // ['I','I']
-			return 30
-		case 74 <= r && r <= 90: // ['J','Z']
-			return 15
+			return 24
+		case 65 <= r && r <= 88: // ['A','X']
+			return 24
+		case r == 89: // ['Y','Y']
+			return 59
+		case r == 90: // ['Z','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 15
+			return 24
 		case 97 <= r && r <= 122: // ['a','z']
-			return 15
+			return 24
 		}
 		return NoState
 	},
@@ -264,15 +302,19 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 15
+			return 24
 		case r == 65: // ['A','A']
-			return 31
-		case 66 <= r && r <= 90: // ['B','Z']
-			return 15
+			return 60
+		case 66 <= r && r <= 78: // ['B','N']
+			return 24
+		case r == 79: // ['O','O']
+			return 61
+		case 80 <= r && r <= 90: // ['P','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 15
+			return 24
 		case 97 <= r && r <= 122: // ['a','z']
-			return 15
+			return 24
 		}
 		return NoState
 	},
@@ -280,17 +322,21 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 15
-		case 65 <= r && r <= 81: // ['A','Q']
-			return 15
-		case r == 82: // ['R','R']
-			return 32
-		case 83 <= r && r <= 90: // ['S','Z']
-			return 15
+			return 24
+		case 65 <= r && r <= 68: // ['A','D']
+			return 24
+		case r == 69: // ['E','E']
+			return 62
+		case 70 <= r && r <= 72: // ['F','H']
+			return 24
+		case r == 73: // ['I','I']
+			return 63
+		case 74 <= r && r <= 90: // ['J','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 15
+			return 24
 		case 97 <= r && r <= 122: // ['a','z']
-			return 15
+			return 24
 		}
 		return NoState
 	},
@@ -298,29 +344,55 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 15
-		case 65 <= r && r <= 68: // ['A','D']
-			return 15
-		case r == 69: // ['E','E']
-			return 33
-		case 70 <= r && r <= 90: // ['F','Z']
-			return 15
+			return 24
+		case 65 <= r && r <= 77: // ['A','M']
+			return 24
+		case r == 78: // ['N','N']
+			return 64
+		case 79 <= r && r <= 87: // ['O','W']
+			return 24
+		case r == 88: // ['X','X']
+			return 65
+		case 89 <= r && r <= 90: // ['Y','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 15
+			return 24
 		case 97 <= r && r <= 122: // ['a','z']
-			return 15
+			return 24
 		}
 		return NoState
 	},
 	// S22
 	func(r rune) int {
 		switch {
-		}
-		return NoState
-	},
-	// S23
-	func(r rune) int {
-		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case r == 65: // ['A','A']
+			return 66
+		case 66 <= r && r <= 90: // ['B','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S23
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 81: // ['A','Q']
+			return 24
+		case r == 82: // ['R','R']
+			return 67
+		case 83 <= r && r <= 90: // ['S','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
 		}
 		return NoState
 	},
@@ -341,27 +413,40 @@ var TransTab = TransitionTable{
 	// S25
 	func(r rune) int {
 		switch {
-		case r == 10: // ['\n','\n']
-			return 34
-		default:
-			return 25
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 77: // ['A','M']
+			return 24
+		case r == 78: // ['N','N']
+			return 68
+		case 79 <= r && r <= 82: // ['O','R']
+			return 24
+		case r == 83: // ['S','S']
+			return 69
+		case 84 <= r && r <= 90: // ['T','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
 		}
+		return NoState
 	},
 	// S26
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 15
-		case 65 <= r && r <= 66: // ['A','B']
-			return 15
-		case r == 67: // ['C','C']
-			return 35
-		case 68 <= r && r <= 90: // ['D','Z']
-			return 15
+			return 24
+		case 65 <= r && r <= 72: // ['A','H']
+			return 24
+		case r == 73: // ['I','I']
+			return 70
+		case 74 <= r && r <= 90: // ['J','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 15
+			return 24
 		case 97 <= r && r <= 122: // ['a','z']
-			return 15
+			return 24
 		}
 		return NoState
 	},
@@ -369,13 +454,15 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 15
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 15
+			return 24
+		case r == 65: // ['A','A']
+			return 71
+		case 66 <= r && r <= 90: // ['B','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 15
+			return 24
 		case 97 <= r && r <= 122: // ['a','z']
-			return 15
+			return 24
 		}
 		return NoState
 	},
@@ -383,17 +470,21 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 15
-		case 65 <= r && r <= 82: // ['A','R']
-			return 15
-		case r == 83: // ['S','S']
-			return 36
-		case 84 <= r && r <= 90: // ['T','Z']
-			return 15
+			return 24
+		case 65 <= r && r <= 78: // ['A','N']
+			return 24
+		case r == 79: // ['O','O']
+			return 72
+		case 80 <= r && r <= 84: // ['P','T']
+			return 24
+		case r == 85: // ['U','U']
+			return 73
+		case 86 <= r && r <= 90: // ['V','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 15
+			return 24
 		case 97 <= r && r <= 122: // ['a','z']
-			return 15
+			return 24
 		}
 		return NoState
 	},
@@ -401,17 +492,21 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 15
-		case 65 <= r && r <= 78: // ['A','N']
-			return 15
-		case r == 79: // ['O','O']
-			return 37
-		case 80 <= r && r <= 90: // ['P','Z']
-			return 15
+			return 24
+		case 65 <= r && r <= 79: // ['A','O']
+			return 24
+		case r == 80: // ['P','P']
+			return 74
+		case r == 81: // ['Q','Q']
+			return 24
+		case r == 82: // ['R','R']
+			return 75
+		case 83 <= r && r <= 90: // ['S','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 15
+			return 24
 		case 97 <= r && r <= 122: // ['a','z']
-			return 15
+			return 24
 		}
 		return NoState
 	},
@@ -419,17 +514,17 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 15
-		case 65 <= r && r <= 76: // ['A','L']
-			return 15
-		case r == 77: // ['M','M']
-			return 38
-		case 78 <= r && r <= 90: // ['N','Z']
-			return 15
+			return 24
+		case 65 <= r && r <= 81: // ['A','Q']
+			return 24
+		case r == 82: // ['R','R']
+			return 76
+		case 83 <= r && r <= 90: // ['S','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 15
+			return 24
 		case 97 <= r && r <= 122: // ['a','z']
-			return 15
+			return 24
 		}
 		return NoState
 	},
@@ -437,17 +532,17 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 15
-		case 65 <= r && r <= 83: // ['A','S']
-			return 15
-		case r == 84: // ['T','T']
-			return 39
-		case 85 <= r && r <= 90: // ['U','Z']
-			return 15
+			return 24
+		case 65 <= r && r <= 68: // ['A','D']
+			return 24
+		case r == 69: // ['E','E']
+			return 77
+		case 70 <= r && r <= 90: // ['F','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 15
+			return 24
 		case 97 <= r && r <= 122: // ['a','z']
-			return 15
+			return 24
 		}
 		return NoState
 	},
@@ -455,17 +550,21 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 15
-		case 65 <= r && r <= 67: // ['A','C']
-			return 15
-		case r == 68: // ['D','D']
-			return 40
-		case 69 <= r && r <= 90: // ['E','Z']
-			return 15
+			return 24
+		case 65 <= r && r <= 74: // ['A','J']
+			return 24
+		case r == 75: // ['K','K']
+			return 78
+		case 76 <= r && r <= 83: // ['L','S']
+			return 24
+		case r == 84: // ['T','T']
+			return 79
+		case 85 <= r && r <= 90: // ['U','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 15
+			return 24
 		case 97 <= r && r <= 122: // ['a','z']
-			return 15
+			return 24
 		}
 		return NoState
 	},
@@ -473,23 +572,35 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 15
-		case 65 <= r && r <= 83: // ['A','S']
-			return 15
-		case r == 84: // ['T','T']
-			return 41
-		case 85 <= r && r <= 90: // ['U','Z']
-			return 15
+			return 24
+		case 65 <= r && r <= 81: // ['A','Q']
+			return 24
+		case r == 82: // ['R','R']
+			return 80
+		case 83 <= r && r <= 90: // ['S','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 15
+			return 24
 		case 97 <= r && r <= 122: // ['a','z']
-			return 15
+			return 24
 		}
 		return NoState
 	},
 	// S34
 	func(r rune) int {
 		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 77: // ['A','M']
+			return 24
+		case r == 78: // ['N','N']
+			return 81
+		case 79 <= r && r <= 90: // ['O','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
 		}
 		return NoState
 	},
@@ -497,13 +608,19 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 15
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 15
+			return 24
+		case 65 <= r && r <= 71: // ['A','G']
+			return 24
+		case r == 72: // ['H','H']
+			return 82
+		case r == 73: // ['I','I']
+			return 83
+		case 74 <= r && r <= 90: // ['J','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 15
+			return 24
 		case 97 <= r && r <= 122: // ['a','z']
-			return 15
+			return 24
 		}
 		return NoState
 	},
@@ -511,299 +628,2438 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 15
-		case 65 <= r && r <= 66: // ['A','B']
-			return 15
-		case r == 67: // ['C','C']
-			return 42
-		case 68 <= r && r <= 90: // ['D','Z']
-			return 15
+			return 24
+		case 65 <= r && r <= 72: // ['A','H']
+			return 24
+		case r == 73: // ['I','I']
+			return 84
+		case 74 <= r && r <= 90: // ['J','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 15
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S37
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S38
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S39
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 39
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 39
+		case r == 95: // ['_','_']
+			return 39
+		case 97 <= r && r <= 122: // ['a','z']
+			return 39
+		}
+		return NoState
+	},
+	// S40
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 39
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 39
+		case r == 95: // ['_','_']
+			return 39
+		case r == 97: // ['a','a']
+			return 85
+		case 98 <= r && r <= 122: // ['b','z']
+			return 39
+		}
+		return NoState
+	},
+	// S41
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 39
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 39
+		case r == 95: // ['_','_']
+			return 39
+		case 97 <= r && r <= 116: // ['a','t']
+			return 39
+		case r == 117: // ['u','u']
+			return 86
+		case 118 <= r && r <= 122: // ['v','z']
+			return 39
+		}
+		return NoState
+	},
+	// S42
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 39
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 39
+		case r == 95: // ['_','_']
+			return 39
+		case 97 <= r && r <= 103: // ['a','g']
+			return 39
+		case r == 104: // ['h','h']
+			return 87
+		case 105 <= r && r <= 122: // ['i','z']
+			return 39
+		}
+		return NoState
+	},
+	// S43
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 39
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 39
+		case r == 95: // ['_','_']
+			return 39
+		case 97 <= r && r <= 113: // ['a','q']
+			return 39
+		case r == 114: // ['r','r']
+			return 88
+		case 115 <= r && r <= 122: // ['s','z']
+			return 39
+		}
+		return NoState
+	},
+	// S44
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S45
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S46
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S47
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S48
+	func(r rune) int {
+		switch {
+		default:
+			return 2
+		}
+	},
+	// S49
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 49
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 49
+		case r == 95: // ['_','_']
+			return 49
+		case 97 <= r && r <= 122: // ['a','z']
+			return 49
+		}
+		return NoState
+	},
+	// S50
+	func(r rune) int {
+		switch {
+		default:
+			return 4
+		}
+	},
+	// S51
+	func(r rune) int {
+		switch {
+		case r == 10: // ['\n','\n']
+			return 89
+		default:
+			return 51
+		}
+	},
+	// S52
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S53
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S54
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S55
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S56
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 75: // ['A','K']
+			return 24
+		case r == 76: // ['L','L']
+			return 90
+		case 77 <= r && r <= 90: // ['M','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S57
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 67: // ['A','C']
+			return 24
+		case r == 68: // ['D','D']
+			return 91
+		case 69 <= r && r <= 90: // ['E','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S58
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 66: // ['A','B']
+			return 24
+		case r == 67: // ['C','C']
+			return 92
+		case 68 <= r && r <= 90: // ['D','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S59
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S60
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 75: // ['A','K']
+			return 24
+		case r == 76: // ['L','L']
+			return 93
+		case 77 <= r && r <= 90: // ['M','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S61
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 77: // ['A','M']
+			return 24
+		case r == 78: // ['N','N']
+			return 94
+		case 79 <= r && r <= 90: // ['O','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S62
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 82: // ['A','R']
+			return 24
+		case r == 83: // ['S','S']
+			return 95
+		case 84 <= r && r <= 90: // ['T','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S63
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 82: // ['A','R']
+			return 24
+		case r == 83: // ['S','S']
+			return 96
+		case 84 <= r && r <= 90: // ['T','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S64
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 67: // ['A','C']
+			return 24
+		case r == 68: // ['D','D']
+			return 97
+		case 69 <= r && r <= 90: // ['E','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S65
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 72: // ['A','H']
+			return 24
+		case r == 73: // ['I','I']
+			return 98
+		case 74 <= r && r <= 79: // ['J','O']
+			return 24
+		case r == 80: // ['P','P']
+			return 99
+		case 81 <= r && r <= 90: // ['Q','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S66
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 75: // ['A','K']
+			return 24
+		case r == 76: // ['L','L']
+			return 100
+		case 77 <= r && r <= 90: // ['M','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S67
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 78: // ['A','N']
+			return 24
+		case r == 79: // ['O','O']
+			return 101
+		case 80 <= r && r <= 90: // ['P','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S68
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S69
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S70
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 76: // ['A','L']
+			return 24
+		case r == 77: // ['M','M']
+			return 102
+		case 78 <= r && r <= 90: // ['N','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S71
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 83: // ['A','S']
+			return 24
+		case r == 84: // ['T','T']
+			return 103
+		case 85 <= r && r <= 90: // ['U','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S72
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 83: // ['A','S']
+			return 24
+		case r == 84: // ['T','T']
+			return 104
+		case 85 <= r && r <= 90: // ['U','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S73
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 75: // ['A','K']
+			return 24
+		case r == 76: // ['L','L']
+			return 105
+		case 77 <= r && r <= 90: // ['M','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S74
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 83: // ['A','S']
+			return 24
+		case r == 84: // ['T','T']
+			return 106
+		case 85 <= r && r <= 90: // ['U','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S75
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 67: // ['A','C']
+			return 24
+		case r == 68: // ['D','D']
+			return 107
+		case 69 <= r && r <= 90: // ['E','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S76
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 78: // ['A','N']
+			return 24
+		case r == 79: // ['O','O']
+			return 108
+		case 80 <= r && r <= 90: // ['P','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S77
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 83: // ['A','S']
+			return 24
+		case r == 84: // ['T','T']
+			return 109
+		case 85 <= r && r <= 90: // ['U','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S78
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 72: // ['A','H']
+			return 24
+		case r == 73: // ['I','I']
+			return 110
+		case 74 <= r && r <= 90: // ['J','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S79
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case r == 65: // ['A','A']
+			return 111
+		case 66 <= r && r <= 90: // ['B','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S80
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 84: // ['A','T']
+			return 24
+		case r == 85: // ['U','U']
+			return 112
+		case 86 <= r && r <= 90: // ['V','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S81
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 72: // ['A','H']
+			return 24
+		case r == 73: // ['I','I']
+			return 113
+		case 74 <= r && r <= 90: // ['J','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S82
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 68: // ['A','D']
+			return 24
+		case r == 69: // ['E','E']
+			return 114
+		case 70 <= r && r <= 90: // ['F','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S83
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 83: // ['A','S']
+			return 24
+		case r == 84: // ['T','T']
+			return 115
+		case 85 <= r && r <= 90: // ['U','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S84
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 68: // ['A','D']
+			return 24
+		case r == 69: // ['E','E']
+			return 116
+		case 70 <= r && r <= 90: // ['F','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S85
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 39
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 39
+		case r == 95: // ['_','_']
+			return 39
+		case 97 <= r && r <= 107: // ['a','k']
+			return 39
+		case r == 108: // ['l','l']
+			return 117
+		case 109 <= r && r <= 122: // ['m','z']
+			return 39
+		}
+		return NoState
+	},
+	// S86
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 39
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 39
+		case r == 95: // ['_','_']
+			return 39
+		case 97 <= r && r <= 107: // ['a','k']
+			return 39
+		case r == 108: // ['l','l']
+			return 118
+		case 109 <= r && r <= 122: // ['m','z']
+			return 39
+		}
+		return NoState
+	},
+	// S87
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 39
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 39
+		case r == 95: // ['_','_']
+			return 39
+		case 97 <= r && r <= 110: // ['a','n']
+			return 39
+		case r == 111: // ['o','o']
+			return 119
+		case 112 <= r && r <= 122: // ['p','z']
+			return 39
+		}
+		return NoState
+	},
+	// S88
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 39
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 39
+		case r == 95: // ['_','_']
+			return 39
+		case 97 <= r && r <= 116: // ['a','t']
+			return 39
+		case r == 117: // ['u','u']
+			return 120
+		case 118 <= r && r <= 122: // ['v','z']
+			return 39
+		}
+		return NoState
+	},
+	// S89
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S90
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S91
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S92
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S93
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 75: // ['A','K']
+			return 24
+		case r == 76: // ['L','L']
+			return 121
+		case 77 <= r && r <= 90: // ['M','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S94
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 83: // ['A','S']
+			return 24
+		case r == 84: // ['T','T']
+			return 122
+		case 85 <= r && r <= 90: // ['U','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S95
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 66: // ['A','B']
+			return 24
+		case r == 67: // ['C','C']
+			return 123
+		case 68 <= r && r <= 90: // ['D','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S96
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 83: // ['A','S']
+			return 24
+		case r == 84: // ['T','T']
+			return 124
+		case 85 <= r && r <= 90: // ['U','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S97
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 82: // ['A','R']
+			return 24
+		case r == 83: // ['S','S']
+			return 125
+		case 84 <= r && r <= 90: // ['T','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S98
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 82: // ['A','R']
+			return 24
+		case r == 83: // ['S','S']
+			return 126
+		case 84 <= r && r <= 90: // ['T','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S99
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 75: // ['A','K']
+			return 24
+		case r == 76: // ['L','L']
+			return 127
+		case 77 <= r && r <= 90: // ['M','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S100
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 82: // ['A','R']
+			return 24
+		case r == 83: // ['S','S']
+			return 128
+		case 84 <= r && r <= 90: // ['T','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S101
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 84: // ['A','T']
+			return 24
+		case r == 85: // ['U','U']
+			return 129
+		case 86 <= r && r <= 90: // ['V','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S102
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 72: // ['A','H']
+			return 24
+		case r == 73: // ['I','I']
+			return 130
+		case 74 <= r && r <= 90: // ['J','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S103
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 66: // ['A','B']
+			return 24
+		case r == 67: // ['C','C']
+			return 131
+		case 68 <= r && r <= 90: // ['D','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S104
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S105
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 75: // ['A','K']
+			return 24
+		case r == 76: // ['L','L']
+			return 132
+		case 77 <= r && r <= 90: // ['M','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S106
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 72: // ['A','H']
+			return 24
+		case r == 73: // ['I','I']
+			return 133
+		case 74 <= r && r <= 90: // ['J','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S107
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 68: // ['A','D']
+			return 24
+		case r == 69: // ['E','E']
+			return 134
+		case 70 <= r && r <= 90: // ['F','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S108
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 69: // ['A','E']
+			return 24
+		case r == 70: // ['F','F']
+			return 135
+		case 71 <= r && r <= 90: // ['G','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S109
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 84: // ['A','T']
+			return 24
+		case r == 85: // ['U','U']
+			return 136
+		case 86 <= r && r <= 90: // ['V','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S110
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 79: // ['A','O']
+			return 24
+		case r == 80: // ['P','P']
+			return 137
+		case 81 <= r && r <= 90: // ['Q','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S111
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 81: // ['A','Q']
+			return 24
+		case r == 82: // ['R','R']
+			return 138
+		case 83 <= r && r <= 90: // ['S','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S112
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 68: // ['A','D']
+			return 24
+		case r == 69: // ['E','E']
+			return 139
+		case 70 <= r && r <= 90: // ['F','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S113
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 78: // ['A','N']
+			return 24
+		case r == 79: // ['O','O']
+			return 140
+		case 80 <= r && r <= 90: // ['P','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S114
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 81: // ['A','Q']
+			return 24
+		case r == 82: // ['R','R']
+			return 141
+		case 83 <= r && r <= 90: // ['S','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S115
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 71: // ['A','G']
+			return 24
+		case r == 72: // ['H','H']
+			return 142
+		case 73 <= r && r <= 90: // ['I','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S116
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 75: // ['A','K']
+			return 24
+		case r == 76: // ['L','L']
+			return 143
+		case 77 <= r && r <= 90: // ['M','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S117
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 39
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 39
+		case r == 95: // ['_','_']
+			return 39
+		case 97 <= r && r <= 114: // ['a','r']
+			return 39
+		case r == 115: // ['s','s']
+			return 144
+		case 116 <= r && r <= 122: // ['t','z']
+			return 39
+		}
+		return NoState
+	},
+	// S118
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 39
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 39
+		case r == 95: // ['_','_']
+			return 39
+		case 97 <= r && r <= 107: // ['a','k']
+			return 39
+		case r == 108: // ['l','l']
+			return 145
+		case 109 <= r && r <= 122: // ['m','z']
+			return 39
+		}
+		return NoState
+	},
+	// S119
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 39
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 39
+		case r == 95: // ['_','_']
+			return 39
+		case 97 <= r && r <= 113: // ['a','q']
+			return 39
+		case r == 114: // ['r','r']
+			return 146
+		case 115 <= r && r <= 122: // ['s','z']
+			return 39
+		}
+		return NoState
+	},
+	// S120
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 39
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 39
+		case r == 95: // ['_','_']
+			return 39
+		case 97 <= r && r <= 100: // ['a','d']
+			return 39
+		case r == 101: // ['e','e']
+			return 147
+		case 102 <= r && r <= 122: // ['f','z']
+			return 39
+		}
+		return NoState
+	},
+	// S121
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S122
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case r == 65: // ['A','A']
+			return 148
+		case 66 <= r && r <= 90: // ['B','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S123
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S124
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 72: // ['A','H']
+			return 24
+		case r == 73: // ['I','I']
+			return 149
+		case 74 <= r && r <= 90: // ['J','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S125
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S126
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 83: // ['A','S']
+			return 24
+		case r == 84: // ['T','T']
+			return 150
+		case 85 <= r && r <= 90: // ['U','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S127
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case r == 65: // ['A','A']
+			return 151
+		case 66 <= r && r <= 90: // ['B','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S128
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 68: // ['A','D']
+			return 24
+		case r == 69: // ['E','E']
+			return 152
+		case 70 <= r && r <= 90: // ['F','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S129
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 79: // ['A','O']
+			return 24
+		case r == 80: // ['P','P']
+			return 153
+		case 81 <= r && r <= 90: // ['Q','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S130
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 83: // ['A','S']
+			return 24
+		case r == 84: // ['T','T']
+			return 154
+		case 85 <= r && r <= 90: // ['U','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S131
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 71: // ['A','G']
+			return 24
+		case r == 72: // ['H','H']
+			return 155
+		case 73 <= r && r <= 90: // ['I','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S132
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S133
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 78: // ['A','N']
+			return 24
+		case r == 79: // ['O','O']
+			return 156
+		case 80 <= r && r <= 90: // ['P','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S134
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 81: // ['A','Q']
+			return 24
+		case r == 82: // ['R','R']
+			return 157
+		case 83 <= r && r <= 90: // ['S','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S135
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 72: // ['A','H']
+			return 24
+		case r == 73: // ['I','I']
+			return 158
+		case 74 <= r && r <= 90: // ['J','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S136
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 81: // ['A','Q']
+			return 24
+		case r == 82: // ['R','R']
+			return 159
+		case 83 <= r && r <= 90: // ['S','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S137
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S138
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 83: // ['A','S']
+			return 24
+		case r == 84: // ['T','T']
+			return 160
+		case 85 <= r && r <= 90: // ['U','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S139
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S140
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 77: // ['A','M']
+			return 24
+		case r == 78: // ['N','N']
+			return 161
+		case 79 <= r && r <= 90: // ['O','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S141
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 68: // ['A','D']
+			return 24
+		case r == 69: // ['E','E']
+			return 162
+		case 70 <= r && r <= 90: // ['F','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S142
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S143
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 67: // ['A','C']
+			return 24
+		case r == 68: // ['D','D']
+			return 163
+		case 69 <= r && r <= 90: // ['E','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S144
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 39
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 39
+		case r == 95: // ['_','_']
+			return 39
+		case 97 <= r && r <= 100: // ['a','d']
+			return 39
+		case r == 101: // ['e','e']
+			return 164
+		case 102 <= r && r <= 122: // ['f','z']
+			return 39
+		}
+		return NoState
+	},
+	// S145
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 39
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 39
+		case r == 95: // ['_','_']
+			return 39
+		case 97 <= r && r <= 122: // ['a','z']
+			return 39
+		}
+		return NoState
+	},
+	// S146
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 39
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 39
+		case r == 95: // ['_','_']
+			return 39
+		case 97 <= r && r <= 115: // ['a','s']
+			return 39
+		case r == 116: // ['t','t']
+			return 165
+		case 117 <= r && r <= 122: // ['u','z']
+			return 39
+		}
+		return NoState
+	},
+	// S147
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 39
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 39
+		case r == 95: // ['_','_']
+			return 39
+		case 97 <= r && r <= 122: // ['a','z']
+			return 39
+		}
+		return NoState
+	},
+	// S148
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 72: // ['A','H']
+			return 24
+		case r == 73: // ['I','I']
+			return 166
+		case 74 <= r && r <= 90: // ['J','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S149
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 77: // ['A','M']
+			return 24
+		case r == 78: // ['N','N']
+			return 167
+		case 79 <= r && r <= 90: // ['O','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S150
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 82: // ['A','R']
+			return 24
+		case r == 83: // ['S','S']
+			return 168
+		case 84 <= r && r <= 90: // ['T','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S151
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 72: // ['A','H']
+			return 24
+		case r == 73: // ['I','I']
+			return 169
+		case 74 <= r && r <= 90: // ['J','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S152
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S153
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S154
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S155
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S156
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 77: // ['A','M']
+			return 24
+		case r == 78: // ['N','N']
+			return 170
+		case 79 <= r && r <= 90: // ['O','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S157
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S158
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 75: // ['A','K']
+			return 24
+		case r == 76: // ['L','L']
+			return 171
+		case 77 <= r && r <= 90: // ['M','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S159
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 77: // ['A','M']
+			return 24
+		case r == 78: // ['N','N']
+			return 172
+		case 79 <= r && r <= 90: // ['O','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S160
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 82: // ['A','R']
+			return 24
+		case r == 83: // ['S','S']
+			return 173
+		case 84 <= r && r <= 90: // ['T','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S161
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S162
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S163
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S164
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 39
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 39
+		case r == 95: // ['_','_']
+			return 39
+		case 97 <= r && r <= 122: // ['a','z']
+			return 39
+		}
+		return NoState
+	},
+	// S165
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 39
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 39
+		case r == 95: // ['_','_']
+			return 39
+		case 97 <= r && r <= 100: // ['a','d']
+			return 39
+		case r == 101: // ['e','e']
+			return 174
+		case 102 <= r && r <= 122: // ['f','z']
+			return 39
+		}
+		return NoState
+	},
+	// S166
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 77: // ['A','M']
+			return 24
+		case r == 78: // ['N','N']
+			return 175
+		case 79 <= r && r <= 90: // ['O','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S167
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 66: // ['A','B']
+			return 24
+		case r == 67: // ['C','C']
+			return 176
+		case 68 <= r && r <= 90: // ['D','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S168
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S169
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 77: // ['A','M']
+			return 24
+		case r == 78: // ['N','N']
+			return 177
+		case 79 <= r && r <= 90: // ['O','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+		}
+		return NoState
+	},
+	// S170
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case r == 65: // ['A','A']
+			return 178
+		case 66 <= r && r <= 90: // ['B','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
 		case 97 <= r && r <= 122: // ['a','z']
-			return 15
+			return 24
 		}
 		return NoState
 	},
-	// S37
+	// S171
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 15
-		case 65 <= r && r <= 84: // ['A','T']
-			return 15
-		case r == 85: // ['U','U']
-			return 43
-		case 86 <= r && r <= 90: // ['V','Z']
-			return 15
+			return 24
+		case 65 <= r && r <= 68: // ['A','D']
+			return 24
+		case r == 69: // ['E','E']
+			return 179
+		case 70 <= r && r <= 90: // ['F','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 15
+			return 24
 		case 97 <= r && r <= 122: // ['a','z']
-			return 15
+			return 24
 		}
 		return NoState
 	},
-	// S38
+	// S172
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 15
-		case 65 <= r && r <= 72: // ['A','H']
-			return 15
-		case r == 73: // ['I','I']
-			return 44
-		case 74 <= r && r <= 90: // ['J','Z']
-			return 15
+			return 24
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 15
+			return 24
 		case 97 <= r && r <= 122: // ['a','z']
-			return 15
+			return 24
 		}
 		return NoState
 	},
-	// S39
+	// S173
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 15
-		case 65 <= r && r <= 66: // ['A','B']
-			return 15
-		case r == 67: // ['C','C']
-			return 45
-		case 68 <= r && r <= 90: // ['D','Z']
-			return 15
+			return 24
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 15
+			return 24
 		case 97 <= r && r <= 122: // ['a','z']
-			return 15
+			return 24
 		}
 		return NoState
 	},
-	// S40
+	// S174
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 15
-		case 65 <= r && r <= 68: // ['A','D']
-			return 15
-		case r == 69: // ['E','E']
-			return 46
-		case 70 <= r && r <= 90: // ['F','Z']
-			return 15
+			return 39
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 39
 		case r == 95: // ['_','_']
-			return 15
+			return 39
+		case 97 <= r && r <= 114: // ['a','r']
+			return 39
+		case r == 115: // ['s','s']
+			return 180
+		case 116 <= r && r <= 122: // ['t','z']
+			return 39
+		}
+		return NoState
+	},
+	// S175
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 24
+		case 65 <= r && r <= 82: // ['A','R']
+			return 24
+		case r == 83: // ['S','S']
+			return 181
+		case 84 <= r && r <= 90: // ['T','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 24
 		case 97 <= r && r <= 122: // ['a','z']
-			return 15
+			return 24
 		}
 		return NoState
 	},
-	// S41
+	// S176
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 15
-		case 65 <= r && r <= 84: // ['A','T']
-			return 15
-		case r == 85: // ['U','U']
-			return 47
-		case 86 <= r && r <= 90: // ['V','Z']
-			return 15
+			return 24
+		case 65 <= r && r <= 83: // ['A','S']
+			return 24
+		case r == 84: // ['T','T']
+			return 182
+		case 85 <= r && r <= 90: // ['U','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 15
+			return 24
 		case 97 <= r && r <= 122: // ['a','z']
-			return 15
+			return 24
 		}
 		return NoState
 	},
-	// S42
+	// S177
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 15
+			return 24
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 15
+			return 24
 		case r == 95: // ['_','_']
-			return 15
+			return 24
 		case 97 <= r && r <= 122: // ['a','z']
-			return 15
+			return 24
 		}
 		return NoState
 	},
-	// S43
+	// S178
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 15
-		case 65 <= r && r <= 79: // ['A','O']
-			return 15
-		case r == 80: // ['P','P']
-			return 48
-		case 81 <= r && r <= 90: // ['Q','Z']
-			return 15
+			return 24
+		case 65 <= r && r <= 75: // ['A','K']
+			return 24
+		case r == 76: // ['L','L']
+			return 183
+		case 77 <= r && r <= 90: // ['M','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 15
+			return 24
 		case 97 <= r && r <= 122: // ['a','z']
-			return 15
+			return 24
 		}
 		return NoState
 	},
-	// S44
+	// S179
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 15
-		case 65 <= r && r <= 83: // ['A','S']
-			return 15
-		case r == 84: // ['T','T']
-			return 49
-		case 85 <= r && r <= 90: // ['U','Z']
-			return 15
+			return 24
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 15
+			return 24
 		case 97 <= r && r <= 122: // ['a','z']
-			return 15
+			return 24
 		}
 		return NoState
 	},
-	// S45
+	// S180
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 15
-		case 65 <= r && r <= 71: // ['A','G']
-			return 15
-		case r == 72: // ['H','H']
-			return 50
-		case 73 <= r && r <= 90: // ['I','Z']
-			return 15
+			return 39
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 39
 		case r == 95: // ['_','_']
-			return 15
-		case 97 <= r && r <= 122: // ['a','z']
-			return 15
+			return 39
+		case 97 <= r && r <= 115: // ['a','s']
+			return 39
+		case r == 116: // ['t','t']
+			return 184
+		case 117 <= r && r <= 122: // ['u','z']
+			return 39
 		}
 		return NoState
 	},
-	// S46
+	// S181
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 15
-		case 65 <= r && r <= 81: // ['A','Q']
-			return 15
-		case r == 82: // ['R','R']
-			return 51
-		case 83 <= r && r <= 90: // ['S','Z']
-			return 15
+			return 24
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 15
+			return 24
 		case 97 <= r && r <= 122: // ['a','z']
-			return 15
+			return 24
 		}
 		return NoState
 	},
-	// S47
+	// S182
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 15
-		case 65 <= r && r <= 81: // ['A','Q']
-			return 15
-		case r == 82: // ['R','R']
-			return 52
-		case 83 <= r && r <= 90: // ['S','Z']
-			return 15
+			return 24
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 15
+			return 24
 		case 97 <= r && r <= 122: // ['a','z']
-			return 15
+			return 24
 		}
 		return NoState
 	},
-	// S48
+	// S183
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 15
+			return 24
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 15
+			return 24
 		case r == 95: // ['_','_']
-			return 15
+			return 24
 		case 97 <= r && r <= 122: // ['a','z']
-			return 15
+			return 24
 		}
 		return NoState
 	},
-	// S49
+	// S184
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 15
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 15
+			return 39
+		case 65 <= r && r <= 79: // ['A','O']
+			return 39
+		case r == 80: // ['P','P']
+			return 185
+		case 81 <= r && r <= 90: // ['Q','Z']
+			return 39
 		case r == 95: // ['_','_']
-			return 15
+			return 39
 		case 97 <= r && r <= 122: // ['a','z']
-			return 15
+			return 39
 		}
 		return NoState
 	},
-	// S50
+	// S185
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 15
+			return 39
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 15
+			return 39
 		case r == 95: // ['_','_']
-			return 15
-		case 97 <= r && r <= 122: // ['a','z']
-			return 15
+			return 39
+		case r == 97: // ['a','a']
+			return 186
+		case 98 <= r && r <= 122: // ['b','z']
+			return 39
 		}
 		return NoState
 	},
-	// S51
+	// S186
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 15
+			return 39
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 15
+			return 39
 		case r == 95: // ['_','_']
-			return 15
-		case 97 <= r && r <= 122: // ['a','z']
-			return 15
+			return 39
+		case 97 <= r && r <= 115: // ['a','s']
+			return 39
+		case r == 116: // ['t','t']
+			return 187
+		case 117 <= r && r <= 122: // ['u','z']
+			return 39
 		}
 		return NoState
 	},
-	// S52
+	// S187
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 15
-		case 65 <= r && r <= 77: // ['A','M']
-			return 15
-		case r == 78: // ['N','N']
-			return 53
-		case 79 <= r && r <= 90: // ['O','Z']
-			return 15
+			return 39
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 39
 		case r == 95: // ['_','_']
-			return 15
-		case 97 <= r && r <= 122: // ['a','z']
-			return 15
+			return 39
+		case 97 <= r && r <= 103: // ['a','g']
+			return 39
+		case r == 104: // ['h','h']
+			return 188
+		case 105 <= r && r <= 122: // ['i','z']
+			return 39
 		}
 		return NoState
 	},
-	// S53
+	// S188
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 15
+			return 39
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 15
+			return 39
 		case r == 95: // ['_','_']
-			return 15
+			return 39
 		case 97 <= r && r <= 122: // ['a','z']
-			return 15
+			return 39
 		}
 		return NoState
 	},
@@ -0,0 +1,78 @@
+package cypher
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// ParseParam parses a name=value query parameter binding. A value that is
+// valid JSON (number, boolean, null, quoted string or list) is decoded as
+// such; anything else is taken as a plain string, so --param name=IndexFile
+// needs no quoting.
+func ParseParam(binding string) (string, interface{}, error) {
+	name, raw, ok := strings.Cut(binding, "=")
+	name = strings.TrimPrefix(strings.TrimSpace(name), "$")
+	if !ok || name == "" {
+		return "", nil, fmt.Errorf("invalid parameter %q (want name=value)", binding)
+	}
+	value, err := decodeParamJSON([]byte(raw))
+	if err != nil {
+		return name, raw, nil
+	}
+	return name, value, nil
+}
+
+// LoadParamsFile reads query parameters from a JSON object file
+func LoadParamsFile(path string) (map[string]interface{}, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read params file: %w", err)
+	}
+	value, err := decodeParamJSON(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse params file %s: %w", path, err)
+	}
+	params, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("params file %s must contain a JSON object", path)
+	}
+	return params, nil
+}
+
+// decodeParamJSON decodes JSON keeping whole numbers as int64, so they
+// compare like the integer literals of a query
+func decodeParamJSON(data []byte) (interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var value interface{}
+	if err := dec.Decode(&value); err != nil {
+		return nil, err
+	}
+	if dec.More() {
+		return nil, fmt.Errorf("unexpected data after JSON value")
+	}
+	return normalizeNumbers(value), nil
+}
+
+func normalizeNumbers(value interface{}) interface{} {
+	switch v := value.(type) {
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		f, _ := v.Float64()
+		return f
+	case []interface{}:
+		for i := range v {
+			v[i] = normalizeNumbers(v[i])
+		}
+	case map[string]interface{}:
+		for k := range v {
+			v[k] = normalizeNumbers(v[k])
+		}
+	}
+	return value
+}
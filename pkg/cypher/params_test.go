@@ -0,0 +1,66 @@
+package cypher
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestParseParam(t *testing.T) {
+	tests := []struct {
+		binding   string
+		wantName  string
+		wantValue interface{}
+		wantErr   bool
+	}{
+		{"name=IndexFile", "name", "IndexFile", false},
+		{"$name=IndexFile", "name", "IndexFile", false},
+		{"depth=3", "depth", int64(3), false},
+		{"weight=0.5", "weight", 0.5, false},
+		{"flag=true", "flag", true, false},
+		{`quoted="42"`, "quoted", "42", false},
+		{`names=["a","b"]`, "names", []interface{}{"a", "b"}, false},
+		{"path=/abs/a.go", "path", "/abs/a.go", false},
+		{"empty=", "empty", "", false},
+		{"novalue", "", nil, true},
+		{"=x", "", nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.binding, func(t *testing.T) {
+			name, value, err := ParseParam(tt.binding)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseParam() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if name != tt.wantName || !reflect.DeepEqual(value, tt.wantValue) {
+				t.Errorf("ParseParam() = %q, %#v, want %q, %#v", name, value, tt.wantName, tt.wantValue)
+			}
+		})
+	}
+}
+
+func TestLoadParamsFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "params.json")
+	if err := os.WriteFile(path, []byte(`{"name": "main", "limit": 10, "tags": ["x"]}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	params, err := LoadParamsFile(path)
+	if err != nil {
+		t.Fatalf("LoadParamsFile() error = %v", err)
+	}
+	want := map[string]interface{}{"name": "main", "limit": int64(10), "tags": []interface{}{"x"}}
+	if !reflect.DeepEqual(params, want) {
+		t.Errorf("LoadParamsFile() = %#v, want %#v", params, want)
+	}
+
+	if err := os.WriteFile(path, []byte(`["not", "an", "object"]`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadParamsFile(path); err == nil {
+		t.Error("Expected error for non-object params file")
+	}
+}
@@ -2,7 +2,7 @@
 
 package parser
 
-const numNTSymbols = 16
+const numNTSymbols = 42
 
 type (
 	gotoTable [numStates]gotoRow
@@ -12,14 +12,39 @@ type (
 var gotoTab = gotoTable{
 	gotoRow{ // S0
 		-1, // S'
-		1,  // Query
-		2,  // MatchClause
+		1,  // Statement
+		2,  // Query
+		5,  // SingleQuery
+		6,  // QueryBody
+		7,  // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		9,  // MatchClauses
+		10, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -27,17 +52,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
 	gotoRow{ // S1
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -45,17 +96,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
 	gotoRow{ // S2
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
-		4,  // ReturnClause
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -63,17 +140,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
 	gotoRow{ // S3
 		-1, // S'
-		-1, // Query
-		-1, // MatchClause
-		6,  // PathPattern
-		7,  // Node
+		-1, // Statement
+		14, // Query
+		5,  // SingleQuery
+		6,  // QueryBody
+		7,  // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		9,  // MatchClauses
+		10, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -81,35 +184,87 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
 	gotoRow{ // S4
 		-1, // S'
-		-1, // Query
-		-1, // MatchClause
+		-1, // Statement
+		15, // Query
+		5,  // SingleQuery
+		6,  // QueryBody
+		7,  // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		9,  // MatchClauses
+		10, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
-		9,  // GroupByClause
+		-1, // FuncName
+		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
-		10, // OrderByClause
+		-1, // OrderByClause
 		-1, // OrderByItems
 		-1, // OrderByItem
-		11, // LimitClause
+		-1, // LimitClause
+		-1, // LimitValue
 	},
 	gotoRow{ // S5
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
-		17, // ReturnItems
-		18, // ReturnItem
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -117,17 +272,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
 	gotoRow{ // S6
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		17, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
-		-1, // ReturnClause
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		16, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -135,17 +316,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
 	gotoRow{ // S7
 		-1, // S'
+		-1, // Statement
 		-1, // Query
-		-1, // MatchClause
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		20, // MatchClauses
+		10, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
-		19, // Edge
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -153,17 +360,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
 	gotoRow{ // S8
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -171,53 +404,131 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
 	gotoRow{ // S9
 		-1, // S'
+		-1, // Statement
 		-1, // Query
-		-1, // MatchClause
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		22, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
-		24, // OrderByClause
+		-1, // OrderByClause
 		-1, // OrderByItems
 		-1, // OrderByItem
-		25, // LimitClause
+		-1, // LimitClause
+		-1, // LimitValue
 	},
 	gotoRow{ // S10
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
 		-1, // OrderByClause
 		-1, // OrderByItems
 		-1, // OrderByItem
-		26, // LimitClause
+		-1, // LimitClause
+		-1, // LimitValue
 	},
 	gotoRow{ // S11
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
-		-1, // PathPattern
-		-1, // Node
+		25, // PatternList
+		26, // Pattern
+		27, // PathPattern
+		28, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -225,17 +536,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
 	gotoRow{ // S12
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -243,17 +580,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
 	gotoRow{ // S13
 		-1, // S'
+		-1, // Statement
 		-1, // Query
-		-1, // MatchClause
+		30, // SingleQuery
+		6,  // QueryBody
+		7,  // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		9,  // MatchClauses
+		10, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -261,17 +624,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
 	gotoRow{ // S14
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -279,17 +668,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
 	gotoRow{ // S15
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -297,35 +712,87 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
 	gotoRow{ // S16
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
-		-1, // GroupByClause
+		-1, // FuncName
+		32, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
-		-1, // OrderByClause
+		33, // OrderByClause
 		-1, // OrderByItems
 		-1, // OrderByItem
-		-1, // LimitClause
+		34, // LimitClause
+		-1, // LimitValue
 	},
 	gotoRow{ // S17
 		-1, // S'
+		-1, // Statement
 		-1, // Query
-		-1, // MatchClause
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		39, // MatchClauses
+		10, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -333,17 +800,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
 	gotoRow{ // S18
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
-		-1, // ReturnItems
-		-1, // ReturnItem
+		41, // ReturnItems
+		44, // ReturnItem
+		45, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -351,17 +844,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
 	gotoRow{ // S19
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
-		33, // Node
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
-		-1, // ReturnItems
-		-1, // ReturnItem
+		47, // ReturnItems
+		49, // ReturnItem
+		50, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -369,17 +888,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
 	gotoRow{ // S20
 		-1, // S'
+		-1, // Statement
 		-1, // Query
-		-1, // MatchClause
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		22, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -387,17 +932,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
 	gotoRow{ // S21
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -405,17 +976,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
 	gotoRow{ // S22
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -423,17 +1020,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
 	gotoRow{ // S23
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -441,35 +1064,87 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
 	gotoRow{ // S24
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		56, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
 		-1, // OrderByClause
 		-1, // OrderByItems
 		-1, // OrderByItem
-		39, // LimitClause
+		-1, // LimitClause
+		-1, // LimitValue
 	},
 	gotoRow{ // S25
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		58, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -477,17 +1152,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
 	gotoRow{ // S26
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -495,53 +1196,131 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
 	gotoRow{ // S27
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
-		-1, // Edge
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		61, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
-		41, // GroupByItems
-		42, // GroupByItem
+		-1, // GroupByItems
+		-1, // GroupByItem
 		-1, // OrderByClause
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
 	gotoRow{ // S28
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
 		-1, // OrderByClause
-		44, // OrderByItems
-		45, // OrderByItem
+		-1, // OrderByItems
+		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
 	gotoRow{ // S29
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
-		-1, // PathPattern
-		-1, // Node
+		64, // PatternList
+		26, // Pattern
+		27, // PathPattern
+		28, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -549,17 +1328,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
 	gotoRow{ // S30
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -567,17 +1372,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
 	gotoRow{ // S31
 		-1, // S'
+		-1, // Statement
 		-1, // Query
-		-1, // MatchClause
+		65, // SingleQuery
+		6,  // QueryBody
+		7,  // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		9,  // MatchClauses
+		10, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -585,53 +1416,131 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
 	gotoRow{ // S32
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
-		48, // ReturnItem
+		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
-		-1, // OrderByClause
+		66, // OrderByClause
 		-1, // OrderByItems
 		-1, // OrderByItem
-		-1, // LimitClause
+		67, // LimitClause
+		-1, // LimitValue
 	},
 	gotoRow{ // S33
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
 		-1, // OrderByClause
 		-1, // OrderByItems
 		-1, // OrderByItem
-		-1, // LimitClause
+		68, // LimitClause
+		-1, // LimitValue
 	},
 	gotoRow{ // S34
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -639,17 +1548,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
 	gotoRow{ // S35
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -657,17 +1592,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
 	gotoRow{ // S36
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -675,17 +1636,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
 	gotoRow{ // S37
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -693,17 +1680,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		73, // LimitValue
 	},
 	gotoRow{ // S38
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -711,17 +1724,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		76, // LimitValue
 	},
 	gotoRow{ // S39
 		-1, // S'
+		-1, // Statement
 		-1, // Query
-		-1, // MatchClause
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		22, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -729,17 +1768,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
 	gotoRow{ // S40
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -747,17 +1812,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
 	gotoRow{ // S41
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		78, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -765,17 +1856,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
 	gotoRow{ // S42
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
-		-1, // ReturnItems
-		-1, // ReturnItem
+		80, // ReturnItems
+		44, // ReturnItem
+		45, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -783,17 +1900,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
 	gotoRow{ // S43
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -801,17 +1944,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
 	gotoRow{ // S44
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -819,17 +1988,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
 	gotoRow{ // S45
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -837,17 +2032,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
 	gotoRow{ // S46
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -855,17 +2076,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
 	gotoRow{ // S47
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -873,17 +2120,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
 	gotoRow{ // S48
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
-		-1, // ReturnItems
-		-1, // ReturnItem
+		84, // ReturnItems
+		49, // ReturnItem
+		50, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -891,17 +2164,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
 	gotoRow{ // S49
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -909,17 +2208,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
 	gotoRow{ // S50
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -927,17 +2252,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
 	gotoRow{ // S51
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -945,17 +2296,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
 	gotoRow{ // S52
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
-		-1, // PathPattern
-		-1, // Node
+		-1, // PatternList
+		-1, // Pattern
+		87, // PathPattern
+		28, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -963,17 +2340,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
 	gotoRow{ // S53
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		91, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -981,17 +2384,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
 	gotoRow{ // S54
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -999,17 +2428,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
 	gotoRow{ // S55
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -1017,17 +2472,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
 	gotoRow{ // S56
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -1035,35 +2516,87 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
 	gotoRow{ // S57
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		95, // PropertyEntries
+		96, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
-		72, // GroupByItem
+		-1, // GroupByItem
 		-1, // OrderByClause
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
 	gotoRow{ // S58
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -1071,17 +2604,175 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
 	gotoRow{ // S59
 		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		97, // Pattern
+		27, // PathPattern
+		28, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S60
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		100, // PathPattern
+		101, // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		106, // OrExpr
+		107, // AndExpr
+		108, // NotExpr
+		110, // Predicate
+		-1,  // CompOp
+		103, // Value
+		-1,  // ValueList
+		113, // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S61
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		121, // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S62
+		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -1089,35 +2780,131 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
-	gotoRow{ // S60
+	gotoRow{ // S63
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
 		-1, // OrderByClause
 		-1, // OrderByItems
-		73, // OrderByItem
+		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
-	gotoRow{ // S61
+	gotoRow{ // S64
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		124, // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S65
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -1125,17 +2912,87 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
-	gotoRow{ // S62
+	gotoRow{ // S66
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		125, // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S67
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -1143,17 +3000,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
-	gotoRow{ // S63
+	gotoRow{ // S68
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -1161,17 +3044,131 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
-	gotoRow{ // S64
+	gotoRow{ // S69
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		127, // GroupByItems
+		128, // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S70
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		130, // OrderByItems
+		131, // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S71
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -1179,17 +3176,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
-	gotoRow{ // S65
+	gotoRow{ // S72
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -1197,17 +3220,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
-	gotoRow{ // S66
+	gotoRow{ // S73
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -1215,17 +3264,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
-	gotoRow{ // S67
+	gotoRow{ // S74
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -1233,17 +3308,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
-	gotoRow{ // S68
+	gotoRow{ // S75
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -1251,17 +3352,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
-	gotoRow{ // S69
+	gotoRow{ // S76
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -1269,17 +3396,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
-	gotoRow{ // S70
+	gotoRow{ // S77
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -1287,17 +3440,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
-	gotoRow{ // S71
+	gotoRow{ // S78
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -1305,17 +3484,131 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
-	gotoRow{ // S72
+	gotoRow{ // S79
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		134, // ReturnItem
+		45,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S80
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		135, // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S81
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -1323,17 +3616,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
-	gotoRow{ // S73
+	gotoRow{ // S82
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -1341,17 +3660,87 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
-	gotoRow{ // S74
+	gotoRow{ // S83
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		140, // ReturnItem
+		50,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S84
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -1359,17 +3748,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
-	gotoRow{ // S75
+	gotoRow{ // S85
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -1377,17 +3792,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
-	gotoRow{ // S76
+	gotoRow{ // S86
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -1395,17 +3836,87 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
-	gotoRow{ // S77
+	gotoRow{ // S87
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		61, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S88
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -1413,17 +3924,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
-	gotoRow{ // S78
+	gotoRow{ // S89
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -1431,17 +3968,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
-	gotoRow{ // S79
+	gotoRow{ // S90
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -1449,17 +4012,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
-	gotoRow{ // S80
+	gotoRow{ // S91
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -1467,17 +4056,87 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
-	gotoRow{ // S81
+	gotoRow{ // S92
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		149, // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S93
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -1485,17 +4144,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
-	gotoRow{ // S82
+	gotoRow{ // S94
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -1503,17 +4188,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
-	gotoRow{ // S83
+	gotoRow{ // S95
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -1521,17 +4232,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
-	gotoRow{ // S84
+	gotoRow{ // S96
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -1539,17 +4276,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
-	gotoRow{ // S85
+	gotoRow{ // S97
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -1557,17 +4320,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
-	gotoRow{ // S86
+	gotoRow{ // S98
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -1575,17 +4364,131 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
-	gotoRow{ // S87
+	gotoRow{ // S99
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		158, // PathPattern
+		101, // Node
+		-1,  // Hops
+		160, // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		162, // OrExpr
+		163, // AndExpr
+		164, // NotExpr
+		166, // Predicate
+		-1,  // CompOp
+		161, // Value
+		-1,  // ValueList
+		113, // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S100
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		168, // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S101
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -1593,17 +4496,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
-	gotoRow{ // S88
+	gotoRow{ // S102
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -1611,17 +4540,87 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
-	gotoRow{ // S89
+	gotoRow{ // S103
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		173, // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S104
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -1629,17 +4628,87 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
-	gotoRow{ // S90
+	gotoRow{ // S105
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		187, // Value
+		185, // ValueList
+		192, // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S106
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -1647,17 +4716,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
-	gotoRow{ // S91
+	gotoRow{ // S107
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -1665,17 +4760,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
-	gotoRow{ // S92
+	gotoRow{ // S108
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -1683,17 +4804,87 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
-	gotoRow{ // S93
+	gotoRow{ // S109
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		100, // PathPattern
+		101, // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		202, // NotExpr
+		110, // Predicate
+		-1,  // CompOp
+		103, // Value
+		-1,  // ValueList
+		113, // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S110
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -1701,17 +4892,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
-	gotoRow{ // S94
+	gotoRow{ // S111
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -1719,17 +4936,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
-	gotoRow{ // S95
+	gotoRow{ // S112
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -1737,17 +4980,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
-	gotoRow{ // S96
+	gotoRow{ // S113
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -1755,17 +5024,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
-	gotoRow{ // S97
+	gotoRow{ // S114
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -1773,17 +5068,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
-	gotoRow{ // S98
+	gotoRow{ // S115
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -1791,17 +5112,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
-	gotoRow{ // S99
+	gotoRow{ // S116
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -1809,17 +5156,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
-	gotoRow{ // S100
+	gotoRow{ // S117
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -1827,17 +5200,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
-	gotoRow{ // S101
+	gotoRow{ // S118
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -1845,17 +5244,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
-	gotoRow{ // S102
+	gotoRow{ // S119
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -1863,17 +5288,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
-	gotoRow{ // S103
+	gotoRow{ // S120
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -1881,17 +5332,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
-	gotoRow{ // S104
+	gotoRow{ // S121
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -1899,17 +5376,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
-	gotoRow{ // S105
+	gotoRow{ // S122
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -1917,17 +5420,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
-	gotoRow{ // S106
+	gotoRow{ // S123
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -1935,17 +5464,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
-	gotoRow{ // S107
+	gotoRow{ // S124
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -1953,17 +5508,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
-	gotoRow{ // S108
+	gotoRow{ // S125
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -1971,17 +5552,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
-	gotoRow{ // S109
+	gotoRow{ // S126
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -1989,17 +5596,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
-	gotoRow{ // S110
+	gotoRow{ // S127
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -2007,17 +5640,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
-	gotoRow{ // S111
+	gotoRow{ // S128
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -2025,17 +5684,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
-	gotoRow{ // S112
+	gotoRow{ // S129
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -2043,17 +5728,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
-	gotoRow{ // S113
+	gotoRow{ // S130
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -2061,17 +5772,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
-	gotoRow{ // S114
+	gotoRow{ // S131
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -2079,17 +5816,87 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
-	gotoRow{ // S115
+	gotoRow{ // S132
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		214, // LimitValue
+	},
+	gotoRow{ // S133
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -2097,17 +5904,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
-	gotoRow{ // S116
+	gotoRow{ // S134
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -2115,17 +5948,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
-	gotoRow{ // S117
+	gotoRow{ // S135
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -2133,17 +5992,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
-	gotoRow{ // S118
+	gotoRow{ // S136
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -2151,17 +6036,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
-	gotoRow{ // S119
+	gotoRow{ // S137
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -2169,17 +6080,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
-	gotoRow{ // S120
+	gotoRow{ // S138
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -2187,17 +6124,43 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
-	gotoRow{ // S121
+	gotoRow{ // S139
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -2205,17 +6168,32911 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
-	gotoRow{ // S122
+	gotoRow{ // S140
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S141
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S142
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S143
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S144
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		231, // Value
+		228, // ValueList
+		235, // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S145
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		244, // PathPattern
+		245, // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S146
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		247, // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S147
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S148
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S149
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S150
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		251, // Value
+		-1,  // ValueList
+		255, // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S151
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		263, // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S152
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S153
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S154
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S155
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		268, // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S156
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		158, // PathPattern
+		101, // Node
+		-1,  // Hops
+		160, // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		269, // OrExpr
+		163, // AndExpr
+		164, // NotExpr
+		166, // Predicate
+		-1,  // CompOp
+		161, // Value
+		-1,  // ValueList
+		113, // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S157
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S158
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		270, // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S159
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S160
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S161
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		273, // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S162
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S163
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S164
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S165
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		158, // PathPattern
+		101, // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		283, // NotExpr
+		166, // Predicate
+		-1,  // CompOp
+		161, // Value
+		-1,  // ValueList
+		113, // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S166
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S167
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S168
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		286, // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S169
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S170
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S171
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S172
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S173
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		290, // Value
+		-1,  // ValueList
+		294, // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S174
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		302, // Value
+		-1,  // ValueList
+		294, // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S175
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S176
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S177
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		305, // Value
+		-1,  // ValueList
+		294, // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S178
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		306, // Value
+		-1,  // ValueList
+		294, // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S179
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S180
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S181
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S182
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S183
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S184
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S185
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S186
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S187
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S188
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S189
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		187, // Value
+		316, // ValueList
+		192, // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S190
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S191
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S192
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S193
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S194
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S195
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S196
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S197
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S198
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S199
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S200
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		100, // PathPattern
+		101, // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		318, // AndExpr
+		108, // NotExpr
+		110, // Predicate
+		-1,  // CompOp
+		103, // Value
+		-1,  // ValueList
+		113, // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S201
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		100, // PathPattern
+		101, // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		319, // NotExpr
+		110, // Predicate
+		-1,  // CompOp
+		103, // Value
+		-1,  // ValueList
+		113, // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S202
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S203
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S204
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		322, // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S205
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		326, // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S206
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S207
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S208
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S209
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S210
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		334, // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S211
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S212
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S213
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		335, // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S214
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S215
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S216
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S217
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S218
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		342, // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S219
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S220
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S221
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S222
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S223
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S224
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		356, // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S225
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S226
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S227
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S228
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S229
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S230
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S231
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S232
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S233
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		187, // Value
+		367, // ValueList
+		192, // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S234
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S235
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S236
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S237
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S238
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S239
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S240
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S241
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S242
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S243
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		372, // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S244
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		374, // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S245
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S246
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S247
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S248
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S249
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S250
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S251
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S252
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S253
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		187, // Value
+		380, // ValueList
+		192, // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S254
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S255
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S256
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S257
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S258
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S259
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S260
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S261
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S262
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S263
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S264
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S265
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S266
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S267
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S268
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S269
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S270
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		388, // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S271
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		390, // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S272
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S273
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		393, // Value
+		-1,  // ValueList
+		397, // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S274
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		405, // Value
+		-1,  // ValueList
+		397, // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S275
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S276
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S277
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		408, // Value
+		-1,  // ValueList
+		397, // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S278
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		409, // Value
+		-1,  // ValueList
+		397, // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S279
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S280
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S281
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		158, // PathPattern
+		101, // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		412, // AndExpr
+		164, // NotExpr
+		166, // Predicate
+		-1,  // CompOp
+		161, // Value
+		-1,  // ValueList
+		113, // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S282
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		158, // PathPattern
+		101, // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		413, // NotExpr
+		166, // Predicate
+		-1,  // CompOp
+		161, // Value
+		-1,  // ValueList
+		113, // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S283
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S284
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S285
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		418, // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S286
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S287
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S288
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S289
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S290
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S291
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S292
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		187, // Value
+		423, // ValueList
+		192, // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S293
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S294
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S295
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S296
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S297
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S298
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S299
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S300
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S301
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S302
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S303
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		425, // Value
+		-1,  // ValueList
+		294, // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S304
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		426, // Value
+		-1,  // ValueList
+		294, // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S305
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S306
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S307
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S308
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S309
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S310
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S311
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S312
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		431, // Value
+		-1,  // ValueList
+		192, // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S313
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S314
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S315
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S316
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S317
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S318
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S319
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S320
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		437, // PatternList
+		438, // Pattern
+		439, // PathPattern
+		440, // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S321
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		441, // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S322
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S323
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S324
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S325
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S326
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S327
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S328
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S329
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S330
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		453, // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S331
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		455, // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S332
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S333
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S334
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S335
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S336
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S337
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S338
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S339
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S340
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S341
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S342
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S343
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S344
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S345
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S346
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S347
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S348
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S349
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S350
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S351
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S352
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S353
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S354
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S355
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S356
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S357
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S358
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S359
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S360
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S361
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S362
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S363
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		475, // Value
+		-1,  // ValueList
+		235, // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S364
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		477, // YieldItems
+		478, // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S365
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S366
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S367
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S368
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S369
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		484, // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S370
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S371
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S372
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S373
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S374
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		487, // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S375
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S376
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S377
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S378
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S379
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S380
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S381
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S382
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S383
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		493, // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S384
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		495, // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S385
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S386
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S387
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		499, // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S388
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S389
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S390
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S391
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S392
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S393
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S394
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S395
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		187, // Value
+		505, // ValueList
+		192, // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S396
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S397
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S398
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S399
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S400
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S401
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S402
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S403
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S404
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S405
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S406
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		507, // Value
+		-1,  // ValueList
+		397, // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S407
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		508, // Value
+		-1,  // ValueList
+		397, // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S408
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S409
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S410
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S411
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S412
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S413
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S414
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		510, // PatternList
+		438, // Pattern
+		439, // PathPattern
+		440, // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S415
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		513, // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S416
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S417
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S418
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S419
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S420
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S421
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S422
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S423
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S424
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S425
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S426
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S427
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S428
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S429
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S430
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S431
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S432
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S433
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S434
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S435
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S436
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		528, // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S437
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		529, // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S438
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S439
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		533, // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S440
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S441
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		534, // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S442
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S443
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S444
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S445
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S446
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S447
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S448
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S449
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S450
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S451
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S452
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		546, // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S453
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S454
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S455
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S456
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S457
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S458
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S459
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S460
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S461
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S462
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S463
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S464
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S465
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S466
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S467
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S468
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S469
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S470
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S471
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S472
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S473
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S474
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		566, // YieldItems
+		478, // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S475
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S476
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S477
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		568, // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S478
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S479
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S480
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S481
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S482
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S483
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S484
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S485
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		575, // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S486
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S487
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S488
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S489
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S490
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S491
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S492
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S493
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S494
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S495
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S496
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		583, // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S497
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S498
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S499
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S500
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S501
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S502
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S503
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S504
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S505
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S506
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S507
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S508
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S509
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S510
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		591, // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S511
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S512
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S513
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S514
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		596, // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S515
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S516
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S517
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S518
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S519
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S520
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S521
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S522
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		600, // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S523
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S524
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		601, // PathPattern
+		440, // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S525
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		605, // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S526
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S527
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S528
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S529
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S530
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		609, // Pattern
+		439, // PathPattern
+		440, // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S531
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S532
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		611, // PathPattern
+		101, // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		613, // OrExpr
+		614, // AndExpr
+		615, // NotExpr
+		617, // Predicate
+		-1,  // CompOp
+		612, // Value
+		-1,  // ValueList
+		113, // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S533
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		619, // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S534
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S535
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S536
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S537
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S538
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S539
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S540
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S541
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S542
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S543
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S544
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S545
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S546
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		630, // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S547
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S548
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S549
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S550
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S551
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S552
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S553
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S554
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S555
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S556
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S557
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S558
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S559
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S560
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S561
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S562
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S563
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S564
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S565
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		645, // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S566
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		646, // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S567
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S568
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S569
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		648, // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S570
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		650, // PathPattern
+		101, // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		652, // OrExpr
+		653, // AndExpr
+		654, // NotExpr
+		656, // Predicate
+		-1,  // CompOp
+		651, // Value
+		-1,  // ValueList
+		113, // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S571
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S572
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		659, // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S573
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S574
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S575
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S576
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S577
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		661, // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S578
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S579
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S580
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S581
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S582
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S583
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S584
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		665, // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S585
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S586
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S587
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S588
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S589
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S590
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S591
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S592
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S593
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		671, // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S594
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S595
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S596
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S597
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S598
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		673, // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S599
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S600
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S601
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		533, // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S602
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S603
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S604
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S605
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S606
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		679, // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S607
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S608
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S609
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S610
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		158, // PathPattern
+		101, // Node
+		-1,  // Hops
+		160, // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		680, // OrExpr
+		163, // AndExpr
+		164, // NotExpr
+		166, // Predicate
+		-1,  // CompOp
+		161, // Value
+		-1,  // ValueList
+		113, // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S611
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		681, // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S612
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		682, // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S613
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S614
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S615
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S616
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		611, // PathPattern
+		101, // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		691, // NotExpr
+		617, // Predicate
+		-1,  // CompOp
+		612, // Value
+		-1,  // ValueList
+		113, // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S617
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S618
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S619
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S620
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S621
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S622
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S623
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S624
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S625
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S626
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S627
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S628
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S629
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S630
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S631
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S632
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S633
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S634
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S635
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S636
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S637
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S638
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S639
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S640
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S641
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S642
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S643
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S644
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S645
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S646
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S647
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S648
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S649
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		158, // PathPattern
+		101, // Node
+		-1,  // Hops
+		160, // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		709, // OrExpr
+		163, // AndExpr
+		164, // NotExpr
+		166, // Predicate
+		-1,  // CompOp
+		161, // Value
+		-1,  // ValueList
+		113, // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S650
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		710, // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S651
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		711, // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S652
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S653
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S654
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S655
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		650, // PathPattern
+		101, // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		720, // NotExpr
+		656, // Predicate
+		-1,  // CompOp
+		651, // Value
+		-1,  // ValueList
+		113, // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S656
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S657
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S658
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S659
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S660
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S661
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S662
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		725, // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S663
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S664
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S665
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S666
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S667
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		727, // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S668
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S669
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S670
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S671
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S672
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S673
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S674
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S675
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		730, // PathPattern
+		245, // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S676
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		732, // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S677
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S678
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S679
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S680
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S681
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		736, // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S682
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		739, // Value
+		-1,  // ValueList
+		743, // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S683
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		751, // Value
+		-1,  // ValueList
+		743, // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S684
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S685
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S686
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		754, // Value
+		-1,  // ValueList
+		743, // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S687
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		755, // Value
+		-1,  // ValueList
+		743, // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S688
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S689
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		611, // PathPattern
+		101, // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		758, // AndExpr
+		615, // NotExpr
+		617, // Predicate
+		-1,  // CompOp
+		612, // Value
+		-1,  // ValueList
+		113, // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S690
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		611, // PathPattern
+		101, // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		759, // NotExpr
+		617, // Predicate
+		-1,  // CompOp
+		612, // Value
+		-1,  // ValueList
+		113, // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S691
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S692
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S693
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S694
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S695
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S696
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S697
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S698
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S699
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S700
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S701
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S702
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S703
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S704
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S705
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S706
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S707
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S708
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S709
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S710
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		771, // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S711
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		774, // Value
+		-1,  // ValueList
+		778, // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S712
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		786, // Value
+		-1,  // ValueList
+		778, // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S713
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S714
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S715
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		789, // Value
+		-1,  // ValueList
+		778, // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S716
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		790, // Value
+		-1,  // ValueList
+		778, // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S717
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S718
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		650, // PathPattern
+		101, // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		793, // AndExpr
+		654, // NotExpr
+		656, // Predicate
+		-1,  // CompOp
+		651, // Value
+		-1,  // ValueList
+		113, // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S719
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		650, // PathPattern
+		101, // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		794, // NotExpr
+		656, // Predicate
+		-1,  // CompOp
+		651, // Value
+		-1,  // ValueList
+		113, // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S720
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S721
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S722
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S723
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S724
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S725
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S726
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S727
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S728
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S729
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S730
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		374, // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S731
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S732
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S733
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S734
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S735
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		803, // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S736
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S737
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S738
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S739
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S740
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S741
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		187, // Value
+		808, // ValueList
+		192, // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S742
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S743
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S744
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S745
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S746
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S747
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S748
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S749
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S750
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S751
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S752
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		810, // Value
+		-1,  // ValueList
+		743, // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S753
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		811, // Value
+		-1,  // ValueList
+		743, // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S754
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S755
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S756
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S757
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S758
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S759
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S760
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		813, // PatternList
+		438, // Pattern
+		439, // PathPattern
+		440, // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S761
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S762
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S763
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S764
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S765
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S766
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S767
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S768
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S769
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S770
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		821, // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S771
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S772
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S773
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S774
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S775
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S776
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		187, // Value
+		826, // ValueList
+		192, // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S777
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S778
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S779
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S780
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S781
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S782
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S783
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S784
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S785
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S786
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S787
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		828, // Value
+		-1,  // ValueList
+		778, // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S788
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		829, // Value
+		-1,  // ValueList
+		778, // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S789
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S790
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S791
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S792
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S793
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S794
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S795
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		831, // PatternList
+		438, // Pattern
+		439, // PathPattern
+		440, // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S796
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S797
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S798
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S799
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S800
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		834, // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S801
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S802
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S803
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S804
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S805
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S806
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S807
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S808
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S809
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S810
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S811
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S812
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S813
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		842, // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S814
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S815
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S816
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S817
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S818
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		848, // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S819
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S820
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S821
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S822
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S823
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S824
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S825
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S826
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S827
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S828
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S829
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S830
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S831
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		856, // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S832
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S833
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S834
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S835
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		861, // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S836
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S837
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S838
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S839
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S840
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S841
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S842
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S843
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S844
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S845
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S846
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S847
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S848
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S849
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		871, // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S850
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S851
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S852
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S853
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S854
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S855
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S856
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S857
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S858
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		877, // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S859
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S860
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S861
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S862
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S863
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		879, // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S864
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S865
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S866
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S867
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S868
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		881, // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		-1,  // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S869
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S870
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S871
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S872
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S873
+		-1,  // S'
+		-1,  // Statement
+		-1,  // Query
+		-1,  // SingleQuery
+		-1,  // QueryBody
+		-1,  // CallClause
+		-1,  // YieldItems
+		-1,  // YieldItem
+		-1,  // WithClause
+		-1,  // MatchClauses
+		-1,  // MatchClause
+		-1,  // PatternList
+		-1,  // Pattern
+		-1,  // PathPattern
+		-1,  // Node
+		-1,  // Hops
+		-1,  // PropertyMap
+		-1,  // PropertyEntries
+		-1,  // PropertyEntry
+		-1,  // Edge
+		-1,  // RelTypes
+		-1,  // WhereClause
+		-1,  // OrExpr
+		-1,  // AndExpr
+		-1,  // NotExpr
+		-1,  // Predicate
+		-1,  // CompOp
+		-1,  // Value
+		-1,  // ValueList
+		883, // Literal
+		-1,  // ReturnClause
+		-1,  // ReturnItems
+		-1,  // ReturnItem
+		-1,  // FuncName
+		-1,  // GroupByClause
+		-1,  // GroupByItems
+		-1,  // GroupByItem
+		-1,  // OrderByClause
+		-1,  // OrderByItems
+		-1,  // OrderByItem
+		-1,  // LimitClause
+		-1,  // LimitValue
+	},
+	gotoRow{ // S874
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S875
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S876
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S877
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S878
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S879
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S880
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S881
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S882
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S883
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S884
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S885
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S886
+		-1, // S'
+		-1, // Statement
+		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
+		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
+		-1, // PathPattern
+		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
+		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
+		-1, // ReturnClause
+		-1, // ReturnItems
+		-1, // ReturnItem
+		-1, // FuncName
+		-1, // GroupByClause
+		-1, // GroupByItems
+		-1, // GroupByItem
+		-1, // OrderByClause
+		-1, // OrderByItems
+		-1, // OrderByItem
+		-1, // LimitClause
+		-1, // LimitValue
+	},
+	gotoRow{ // S887
 		-1, // S'
+		-1, // Statement
 		-1, // Query
+		-1, // SingleQuery
+		-1, // QueryBody
+		-1, // CallClause
+		-1, // YieldItems
+		-1, // YieldItem
+		-1, // WithClause
+		-1, // MatchClauses
 		-1, // MatchClause
+		-1, // PatternList
+		-1, // Pattern
 		-1, // PathPattern
 		-1, // Node
+		-1, // Hops
+		-1, // PropertyMap
+		-1, // PropertyEntries
+		-1, // PropertyEntry
 		-1, // Edge
+		-1, // RelTypes
+		-1, // WhereClause
+		-1, // OrExpr
+		-1, // AndExpr
+		-1, // NotExpr
+		-1, // Predicate
+		-1, // CompOp
+		-1, // Value
+		-1, // ValueList
+		-1, // Literal
 		-1, // ReturnClause
 		-1, // ReturnItems
 		-1, // ReturnItem
+		-1, // FuncName
 		-1, // GroupByClause
 		-1, // GroupByItems
 		-1, // GroupByItem
@@ -2223,5 +39080,6 @@ var gotoTab = gotoTable{
 		-1, // OrderByItems
 		-1, // OrderByItem
 		-1, // LimitClause
+		-1, // LimitValue
 	},
 }
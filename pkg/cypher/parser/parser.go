@@ -11,9 +11,9 @@ import (
 )
 
 const (
-	numProductions = 45
-	numStates      = 123
-	numSymbols     = 41
+	numProductions = 182
+	numStates      = 888
+	numSymbols     = 104
 )
 
 // Stack
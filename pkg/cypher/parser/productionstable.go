@@ -20,7 +20,7 @@ type (
 
 var productionsTable = ProdTab{
 	ProdTabEntry{
-		String:     `S' : Query	<<  >>`,
+		String:     `S' : Statement	<<  >>`,
 		Id:         "S'",
 		NTType:     0,
 		Index:      0,
@@ -30,443 +30,1813 @@ var productionsTable = ProdTab{
 		},
 	},
 	ProdTabEntry{
-		String:     `Query : MatchClause ReturnClause GroupByClause OrderByClause LimitClause	<< ast.NewQueryWithClauses(X[0], X[1], X[2], X[3], X[4]) >>`,
-		Id:         "Query",
+		String:     `Statement : Query	<<  >>`,
+		Id:         "Statement",
 		NTType:     1,
 		Index:      1,
-		NumSymbols: 5,
+		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.NewQueryWithClauses(X[0], X[1], X[2], X[3], X[4])
+			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String:     `Query : MatchClause ReturnClause GroupByClause OrderByClause	<< ast.NewQueryWithClauses(X[0], X[1], X[2], X[3], nil) >>`,
-		Id:         "Query",
+		String:     `Statement : "EXPLAIN" Query	<< ast.SetMode(X[1], "explain") >>`,
+		Id:         "Statement",
 		NTType:     1,
 		Index:      2,
-		NumSymbols: 4,
+		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.NewQueryWithClauses(X[0], X[1], X[2], X[3], nil)
+			return ast.SetMode(X[1], "explain")
 		},
 	},
 	ProdTabEntry{
-		String:     `Query : MatchClause ReturnClause GroupByClause LimitClause	<< ast.NewQueryWithClauses(X[0], X[1], X[2], nil, X[3]) >>`,
-		Id:         "Query",
+		String:     `Statement : "PROFILE" Query	<< ast.SetMode(X[1], "profile") >>`,
+		Id:         "Statement",
 		NTType:     1,
 		Index:      3,
-		NumSymbols: 4,
+		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.NewQueryWithClauses(X[0], X[1], X[2], nil, X[3])
+			return ast.SetMode(X[1], "profile")
 		},
 	},
 	ProdTabEntry{
-		String:     `Query : MatchClause ReturnClause OrderByClause LimitClause	<< ast.NewQueryWithClauses(X[0], X[1], nil, X[2], X[3]) >>`,
+		String:     `Query : SingleQuery	<<  >>`,
 		Id:         "Query",
-		NTType:     1,
+		NTType:     2,
 		Index:      4,
-		NumSymbols: 4,
+		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.NewQueryWithClauses(X[0], X[1], nil, X[2], X[3])
+			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String:     `Query : MatchClause ReturnClause GroupByClause	<< ast.NewQueryWithClauses(X[0], X[1], X[2], nil, nil) >>`,
+		String:     `Query : Query "UNION" SingleQuery	<< ast.AppendUnion(X[0], X[2], false) >>`,
 		Id:         "Query",
-		NTType:     1,
+		NTType:     2,
 		Index:      5,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.NewQueryWithClauses(X[0], X[1], X[2], nil, nil)
+			return ast.AppendUnion(X[0], X[2], false)
 		},
 	},
 	ProdTabEntry{
-		String:     `Query : MatchClause ReturnClause OrderByClause	<< ast.NewQueryWithClauses(X[0], X[1], nil, X[2], nil) >>`,
+		String:     `Query : Query "UNION" "ALL" SingleQuery	<< ast.AppendUnion(X[0], X[3], true) >>`,
 		Id:         "Query",
-		NTType:     1,
+		NTType:     2,
 		Index:      6,
-		NumSymbols: 3,
+		NumSymbols: 4,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.NewQueryWithClauses(X[0], X[1], nil, X[2], nil)
+			return ast.AppendUnion(X[0], X[3], true)
 		},
 	},
 	ProdTabEntry{
-		String:     `Query : MatchClause ReturnClause LimitClause	<< ast.NewQueryWithClauses(X[0], X[1], nil, nil, X[2]) >>`,
-		Id:         "Query",
-		NTType:     1,
+		String:     `SingleQuery : QueryBody ReturnClause GroupByClause OrderByClause LimitClause	<< ast.NewQueryWithClauses(X[0], X[1], X[2], X[3], X[4]) >>`,
+		Id:         "SingleQuery",
+		NTType:     3,
 		Index:      7,
-		NumSymbols: 3,
+		NumSymbols: 5,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.NewQueryWithClauses(X[0], X[1], nil, nil, X[2])
+			return ast.NewQueryWithClauses(X[0], X[1], X[2], X[3], X[4])
 		},
 	},
 	ProdTabEntry{
-		String:     `Query : MatchClause ReturnClause	<< ast.NewQuery(X[0], X[1]) >>`,
-		Id:         "Query",
-		NTType:     1,
+		String:     `SingleQuery : QueryBody ReturnClause GroupByClause OrderByClause	<< ast.NewQueryWithClauses(X[0], X[1], X[2], X[3], nil) >>`,
+		Id:         "SingleQuery",
+		NTType:     3,
 		Index:      8,
-		NumSymbols: 2,
+		NumSymbols: 4,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.NewQuery(X[0], X[1])
+			return ast.NewQueryWithClauses(X[0], X[1], X[2], X[3], nil)
 		},
 	},
 	ProdTabEntry{
-		String:     `MatchClause : "MATCH" PathPattern	<< ast.NewMatchClause(X[1]) >>`,
-		Id:         "MatchClause",
-		NTType:     2,
+		String:     `SingleQuery : QueryBody ReturnClause GroupByClause LimitClause	<< ast.NewQueryWithClauses(X[0], X[1], X[2], nil, X[3]) >>`,
+		Id:         "SingleQuery",
+		NTType:     3,
 		Index:      9,
-		NumSymbols: 2,
+		NumSymbols: 4,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.NewMatchClause(X[1])
+			return ast.NewQueryWithClauses(X[0], X[1], X[2], nil, X[3])
 		},
 	},
 	ProdTabEntry{
-		String:     `PathPattern : Node Edge Node	<< ast.NewPathPattern(X[0], X[1], X[2]) >>`,
-		Id:         "PathPattern",
+		String:     `SingleQuery : QueryBody ReturnClause OrderByClause LimitClause	<< ast.NewQueryWithClauses(X[0], X[1], nil, X[2], X[3]) >>`,
+		Id:         "SingleQuery",
 		NTType:     3,
 		Index:      10,
-		NumSymbols: 3,
+		NumSymbols: 4,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.NewPathPattern(X[0], X[1], X[2])
+			return ast.NewQueryWithClauses(X[0], X[1], nil, X[2], X[3])
 		},
 	},
 	ProdTabEntry{
-		String:     `Node : "(" id ":" upid ")"	<< ast.NewNodeLabeled(X[1], X[3]) >>`,
-		Id:         "Node",
-		NTType:     4,
+		String:     `SingleQuery : QueryBody ReturnClause GroupByClause	<< ast.NewQueryWithClauses(X[0], X[1], X[2], nil, nil) >>`,
+		Id:         "SingleQuery",
+		NTType:     3,
 		Index:      11,
-		NumSymbols: 5,
+		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.NewNodeLabeled(X[1], X[3])
+			return ast.NewQueryWithClauses(X[0], X[1], X[2], nil, nil)
 		},
 	},
 	ProdTabEntry{
-		String:     `Node : "(" id ")"	<< ast.NewNodeVar(X[1]) >>`,
-		Id:         "Node",
-		NTType:     4,
+		String:     `SingleQuery : QueryBody ReturnClause OrderByClause	<< ast.NewQueryWithClauses(X[0], X[1], nil, X[2], nil) >>`,
+		Id:         "SingleQuery",
+		NTType:     3,
 		Index:      12,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.NewNodeVar(X[1])
+			return ast.NewQueryWithClauses(X[0], X[1], nil, X[2], nil)
 		},
 	},
 	ProdTabEntry{
-		String:     `Node : "(" ")"	<< ast.NewNodeAnon() >>`,
-		Id:         "Node",
-		NTType:     4,
+		String:     `SingleQuery : QueryBody ReturnClause LimitClause	<< ast.NewQueryWithClauses(X[0], X[1], nil, nil, X[2]) >>`,
+		Id:         "SingleQuery",
+		NTType:     3,
 		Index:      13,
-		NumSymbols: 2,
+		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.NewNodeAnon()
+			return ast.NewQueryWithClauses(X[0], X[1], nil, nil, X[2])
 		},
 	},
 	ProdTabEntry{
-		String:     `Edge : "-" "[" ":" id "]" "-" ">"	<< ast.NewEdgeForward(X[3]) >>`,
-		Id:         "Edge",
-		NTType:     5,
+		String:     `SingleQuery : QueryBody ReturnClause	<< ast.NewQuery(X[0], X[1]) >>`,
+		Id:         "SingleQuery",
+		NTType:     3,
 		Index:      14,
-		NumSymbols: 7,
+		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.NewEdgeForward(X[3])
+			return ast.NewQuery(X[0], X[1])
 		},
 	},
 	ProdTabEntry{
-		String:     `Edge : "-" "[" ":" id "*" int "." "." int "]" "-" ">"	<< ast.NewEdgeMultiHopForward(X[3], X[5], X[8]) >>`,
-		Id:         "Edge",
-		NTType:     5,
+		String:     `SingleQuery : CallClause	<< ast.NewCallQuery(X[0]) >>`,
+		Id:         "SingleQuery",
+		NTType:     3,
 		Index:      15,
-		NumSymbols: 12,
+		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.NewEdgeMultiHopForward(X[3], X[5], X[8])
+			return ast.NewCallQuery(X[0])
 		},
 	},
 	ProdTabEntry{
-		String:     `Edge : "-" "[" ":" id "*" int "]" "-" ">"	<< ast.NewEdgeMultiHopForward(X[3], X[5], X[5]) >>`,
-		Id:         "Edge",
-		NTType:     5,
+		String:     `SingleQuery : "CALL" id "." id "(" ValueList ")"	<< ast.NewStandaloneCall(X[1], X[3], X[5]) >>`,
+		Id:         "SingleQuery",
+		NTType:     3,
 		Index:      16,
-		NumSymbols: 9,
+		NumSymbols: 7,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.NewEdgeMultiHopForward(X[3], X[5], X[5])
+			return ast.NewStandaloneCall(X[1], X[3], X[5])
 		},
 	},
 	ProdTabEntry{
-		String:     `Edge : "-" "[" "*" int "." "." int "]" "-" ">"	<< ast.NewEdgeMultiHopForward(nil, X[3], X[6]) >>`,
-		Id:         "Edge",
-		NTType:     5,
+		String:     `SingleQuery : "CALL" id "." id "(" ")"	<< ast.NewStandaloneCall(X[1], X[3], nil) >>`,
+		Id:         "SingleQuery",
+		NTType:     3,
 		Index:      17,
-		NumSymbols: 10,
+		NumSymbols: 6,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.NewEdgeMultiHopForward(nil, X[3], X[6])
+			return ast.NewStandaloneCall(X[1], X[3], nil)
 		},
 	},
 	ProdTabEntry{
-		String:     `Edge : "-" "[" "*" int "]" "-" ">"	<< ast.NewEdgeMultiHopForward(nil, X[3], X[3]) >>`,
-		Id:         "Edge",
-		NTType:     5,
+		String:     `QueryBody : MatchClauses	<< ast.NewQueryBody(X[0]) >>`,
+		Id:         "QueryBody",
+		NTType:     4,
 		Index:      18,
-		NumSymbols: 7,
+		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.NewEdgeMultiHopForward(nil, X[3], X[3])
+			return ast.NewQueryBody(X[0])
 		},
 	},
 	ProdTabEntry{
-		String:     `Edge : "<" "-" "[" ":" id "]" "-"	<< ast.NewEdgeBackward(X[4]) >>`,
-		Id:         "Edge",
-		NTType:     5,
+		String:     `QueryBody : CallClause MatchClauses	<< ast.NewCallBody(X[0], X[1]) >>`,
+		Id:         "QueryBody",
+		NTType:     4,
 		Index:      19,
-		NumSymbols: 7,
+		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.NewEdgeBackward(X[4])
+			return ast.NewCallBody(X[0], X[1])
 		},
 	},
 	ProdTabEntry{
-		String:     `Edge : "<" "-" "[" ":" id "*" int "." "." int "]" "-"	<< ast.NewEdgeMultiHopBackward(X[4], X[6], X[9]) >>`,
-		Id:         "Edge",
-		NTType:     5,
+		String:     `QueryBody : CallClause	<< ast.NewCallBody(X[0], nil) >>`,
+		Id:         "QueryBody",
+		NTType:     4,
 		Index:      20,
-		NumSymbols: 12,
+		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.NewEdgeMultiHopBackward(X[4], X[6], X[9])
+			return ast.NewCallBody(X[0], nil)
 		},
 	},
 	ProdTabEntry{
-		String:     `Edge : "<" "-" "[" ":" id "*" int "]" "-"	<< ast.NewEdgeMultiHopBackward(X[4], X[6], X[6]) >>`,
-		Id:         "Edge",
-		NTType:     5,
+		String:     `QueryBody : QueryBody WithClause MatchClauses	<< ast.AppendWithStage(X[0], X[1], X[2]) >>`,
+		Id:         "QueryBody",
+		NTType:     4,
 		Index:      21,
-		NumSymbols: 9,
+		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.NewEdgeMultiHopBackward(X[4], X[6], X[6])
+			return ast.AppendWithStage(X[0], X[1], X[2])
 		},
 	},
 	ProdTabEntry{
-		String:     `Edge : "<" "-" "[" "*" int "." "." int "]" "-"	<< ast.NewEdgeMultiHopBackward(nil, X[4], X[7]) >>`,
-		Id:         "Edge",
-		NTType:     5,
+		String:     `QueryBody : QueryBody WithClause	<< ast.AppendWithStage(X[0], X[1], nil) >>`,
+		Id:         "QueryBody",
+		NTType:     4,
 		Index:      22,
-		NumSymbols: 10,
+		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.NewEdgeMultiHopBackward(nil, X[4], X[7])
+			return ast.AppendWithStage(X[0], X[1], nil)
 		},
 	},
 	ProdTabEntry{
-		String:     `Edge : "<" "-" "[" "*" int "]" "-"	<< ast.NewEdgeMultiHopBackward(nil, X[4], X[4]) >>`,
-		Id:         "Edge",
+		String:     `CallClause : "CALL" id "." id "(" ValueList ")" "YIELD" YieldItems	<< ast.NewCallClause(X[1], X[3], X[5], X[8]) >>`,
+		Id:         "CallClause",
 		NTType:     5,
 		Index:      23,
-		NumSymbols: 7,
+		NumSymbols: 9,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.NewEdgeMultiHopBackward(nil, X[4], X[4])
+			return ast.NewCallClause(X[1], X[3], X[5], X[8])
 		},
 	},
 	ProdTabEntry{
-		String:     `Edge : "-" "[" "]" "-" ">"	<< ast.NewEdgeAnyForward() >>`,
-		Id:         "Edge",
+		String:     `CallClause : "CALL" id "." id "(" ")" "YIELD" YieldItems	<< ast.NewCallClause(X[1], X[3], nil, X[7]) >>`,
+		Id:         "CallClause",
 		NTType:     5,
 		Index:      24,
-		NumSymbols: 5,
+		NumSymbols: 8,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.NewEdgeAnyForward()
+			return ast.NewCallClause(X[1], X[3], nil, X[7])
 		},
 	},
 	ProdTabEntry{
-		String:     `Edge : "-" "[" "]" "-"	<< ast.NewEdgeAny() >>`,
-		Id:         "Edge",
+		String:     `CallClause : "CALL" id "." id "(" ValueList ")" "YIELD" YieldItems WhereClause	<< ast.FilterCall(X[1], X[3], X[5], X[8], X[9]) >>`,
+		Id:         "CallClause",
 		NTType:     5,
 		Index:      25,
-		NumSymbols: 4,
+		NumSymbols: 10,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.NewEdgeAny()
+			return ast.FilterCall(X[1], X[3], X[5], X[8], X[9])
 		},
 	},
 	ProdTabEntry{
-		String:     `ReturnClause : "RETURN" ReturnItems	<< ast.NewReturnClause(X[1]) >>`,
-		Id:         "ReturnClause",
-		NTType:     6,
+		String:     `CallClause : "CALL" id "." id "(" ")" "YIELD" YieldItems WhereClause	<< ast.FilterCall(X[1], X[3], nil, X[7], X[8]) >>`,
+		Id:         "CallClause",
+		NTType:     5,
 		Index:      26,
-		NumSymbols: 2,
+		NumSymbols: 9,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.NewReturnClause(X[1])
+			return ast.FilterCall(X[1], X[3], nil, X[7], X[8])
 		},
 	},
 	ProdTabEntry{
-		String:     `ReturnItems : ReturnItem	<< ast.NewReturnItems(X[0]) >>`,
-		Id:         "ReturnItems",
-		NTType:     7,
+		String:     `YieldItems : YieldItem	<< ast.NewYieldItems(X[0]) >>`,
+		Id:         "YieldItems",
+		NTType:     6,
 		Index:      27,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.NewReturnItems(X[0])
+			return ast.NewYieldItems(X[0])
 		},
 	},
 	ProdTabEntry{
-		String:     `ReturnItems : ReturnItems "," ReturnItem	<< ast.AppendReturnItem(X[0], X[2]) >>`,
-		Id:         "ReturnItems",
-		NTType:     7,
+		String:     `YieldItems : YieldItems "," YieldItem	<< ast.AppendYieldItem(X[0], X[2]) >>`,
+		Id:         "YieldItems",
+		NTType:     6,
 		Index:      28,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.AppendReturnItem(X[0], X[2])
+			return ast.AppendYieldItem(X[0], X[2])
 		},
 	},
 	ProdTabEntry{
-		String:     `ReturnItem : upid "(" id ")" "AS" id	<< ast.NewReturnAggregateWithAlias(X[0], X[2], X[5]) >>`,
-		Id:         "ReturnItem",
-		NTType:     8,
+		String:     `YieldItem : id "AS" id	<< ast.NewYieldItem(X[0], X[2]) >>`,
+		Id:         "YieldItem",
+		NTType:     7,
 		Index:      29,
-		NumSymbols: 6,
+		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.NewReturnAggregateWithAlias(X[0], X[2], X[5])
+			return ast.NewYieldItem(X[0], X[2])
 		},
 	},
 	ProdTabEntry{
-		String:     `ReturnItem : upid "(" id ")"	<< ast.NewReturnAggregate(X[0], X[2]) >>`,
-		Id:         "ReturnItem",
-		NTType:     8,
+		String:     `YieldItem : id	<< ast.NewYieldItem(X[0], nil) >>`,
+		Id:         "YieldItem",
+		NTType:     7,
 		Index:      30,
-		NumSymbols: 4,
+		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.NewReturnAggregate(X[0], X[2])
+			return ast.NewYieldItem(X[0], nil)
 		},
 	},
 	ProdTabEntry{
-		String:     `ReturnItem : id "." id "AS" id	<< ast.NewReturnPropWithAlias(X[0], X[2], X[4]) >>`,
-		Id:         "ReturnItem",
+		String:     `WithClause : "WITH" ReturnItems WhereClause	<< ast.NewWithClause(X[1], X[2], false) >>`,
+		Id:         "WithClause",
 		NTType:     8,
 		Index:      31,
-		NumSymbols: 5,
+		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.NewReturnPropWithAlias(X[0], X[2], X[4])
+			return ast.NewWithClause(X[1], X[2], false)
 		},
 	},
 	ProdTabEntry{
-		String:     `ReturnItem : id "." id	<< ast.NewReturnProp(X[0], X[2]) >>`,
-		Id:         "ReturnItem",
+		String:     `WithClause : "WITH" ReturnItems	<< ast.NewWithClause(X[1], nil, false) >>`,
+		Id:         "WithClause",
 		NTType:     8,
 		Index:      32,
-		NumSymbols: 3,
+		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.NewReturnProp(X[0], X[2])
+			return ast.NewWithClause(X[1], nil, false)
 		},
 	},
 	ProdTabEntry{
-		String:     `ReturnItem : id	<< ast.NewReturnVar(X[0]) >>`,
-		Id:         "ReturnItem",
+		String:     `WithClause : "WITH" "DISTINCT" ReturnItems WhereClause	<< ast.NewWithClause(X[2], X[3], true) >>`,
+		Id:         "WithClause",
 		NTType:     8,
 		Index:      33,
-		NumSymbols: 1,
+		NumSymbols: 4,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.NewReturnVar(X[0])
+			return ast.NewWithClause(X[2], X[3], true)
 		},
 	},
 	ProdTabEntry{
-		String:     `GroupByClause : "GROUP" "BY" GroupByItems	<< ast.NewGroupByClause(X[2]) >>`,
-		Id:         "GroupByClause",
-		NTType:     9,
+		String:     `WithClause : "WITH" "DISTINCT" ReturnItems	<< ast.NewWithClause(X[2], nil, true) >>`,
+		Id:         "WithClause",
+		NTType:     8,
 		Index:      34,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.NewGroupByClause(X[2])
+			return ast.NewWithClause(X[2], nil, true)
 		},
 	},
 	ProdTabEntry{
-		String:     `GroupByItems : GroupByItem	<< ast.NewGroupByItems(X[0]) >>`,
-		Id:         "GroupByItems",
-		NTType:     10,
+		String:     `MatchClauses : MatchClause	<< ast.NewMatchClauses(X[0]) >>`,
+		Id:         "MatchClauses",
+		NTType:     9,
 		Index:      35,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.NewGroupByItems(X[0])
+			return ast.NewMatchClauses(X[0])
 		},
 	},
 	ProdTabEntry{
-		String:     `GroupByItems : GroupByItems "," GroupByItem	<< ast.AppendGroupByItem(X[0], X[2]) >>`,
-		Id:         "GroupByItems",
-		NTType:     10,
+		String:     `MatchClauses : MatchClauses MatchClause	<< ast.AppendMatchClause(X[0], X[1]) >>`,
+		Id:         "MatchClauses",
+		NTType:     9,
 		Index:      36,
-		NumSymbols: 3,
+		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.AppendGroupByItem(X[0], X[2])
+			return ast.AppendMatchClause(X[0], X[1])
 		},
 	},
 	ProdTabEntry{
-		String:     `GroupByItem : id "." id	<< ast.NewGroupByItem(X[0], X[2]) >>`,
-		Id:         "GroupByItem",
-		NTType:     11,
+		String:     `MatchClause : "MATCH" PatternList WhereClause	<< ast.NewMatchClause(X[1], X[2]) >>`,
+		Id:         "MatchClause",
+		NTType:     10,
 		Index:      37,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.NewGroupByItem(X[0], X[2])
+			return ast.NewMatchClause(X[1], X[2])
 		},
 	},
 	ProdTabEntry{
-		String:     `OrderByClause : "ORDER" "BY" OrderByItems	<< ast.NewOrderByClause(X[2]) >>`,
-		Id:         "OrderByClause",
-		NTType:     12,
+		String:     `MatchClause : "MATCH" PatternList	<< ast.NewMatchClause(X[1], nil) >>`,
+		Id:         "MatchClause",
+		NTType:     10,
 		Index:      38,
-		NumSymbols: 3,
+		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.NewOrderByClause(X[2])
+			return ast.NewMatchClause(X[1], nil)
 		},
 	},
 	ProdTabEntry{
-		String:     `OrderByItems : OrderByItem	<< ast.NewOrderByItems(X[0]) >>`,
-		Id:         "OrderByItems",
-		NTType:     13,
+		String:     `MatchClause : "OPTIONAL" "MATCH" PatternList WhereClause	<< ast.NewOptionalMatchClause(X[2], X[3]) >>`,
+		Id:         "MatchClause",
+		NTType:     10,
 		Index:      39,
-		NumSymbols: 1,
+		NumSymbols: 4,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.NewOrderByItems(X[0])
+			return ast.NewOptionalMatchClause(X[2], X[3])
 		},
 	},
 	ProdTabEntry{
-		String:     `OrderByItems : OrderByItems "," OrderByItem	<< ast.AppendOrderByItem(X[0], X[2]) >>`,
-		Id:         "OrderByItems",
-		NTType:     13,
+		String:     `MatchClause : "OPTIONAL" "MATCH" PatternList	<< ast.NewOptionalMatchClause(X[2], nil) >>`,
+		Id:         "MatchClause",
+		NTType:     10,
 		Index:      40,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.AppendOrderByItem(X[0], X[2])
+			return ast.NewOptionalMatchClause(X[2], nil)
 		},
 	},
 	ProdTabEntry{
-		String:     `OrderByItem : id "ASC"	<< ast.NewOrderByItemAsc(X[0]) >>`,
-		Id:         "OrderByItem",
-		NTType:     14,
+		String:     `PatternList : Pattern	<< ast.NewPatternList(X[0]) >>`,
+		Id:         "PatternList",
+		NTType:     11,
 		Index:      41,
-		NumSymbols: 2,
+		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.NewOrderByItemAsc(X[0])
+			return ast.NewPatternList(X[0])
 		},
 	},
 	ProdTabEntry{
-		String:     `OrderByItem : id "DESC"	<< ast.NewOrderByItemDesc(X[0]) >>`,
-		Id:         "OrderByItem",
-		NTType:     14,
+		String:     `PatternList : PatternList "," Pattern	<< ast.AppendPattern(X[0], X[2]) >>`,
+		Id:         "PatternList",
+		NTType:     11,
 		Index:      42,
-		NumSymbols: 2,
+		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.NewOrderByItemDesc(X[0])
+			return ast.AppendPattern(X[0], X[2])
 		},
 	},
 	ProdTabEntry{
-		String:     `OrderByItem : id	<< ast.NewOrderByItem(X[0]) >>`,
-		Id:         "OrderByItem",
-		NTType:     14,
+		String:     `Pattern : PathPattern	<<  >>`,
+		Id:         "Pattern",
+		NTType:     12,
 		Index:      43,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.NewOrderByItem(X[0])
+			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String:     `LimitClause : "LIMIT" int	<< ast.NewLimitClause(X[1]) >>`,
-		Id:         "LimitClause",
-		NTType:     15,
+		String:     `Pattern : id "=" PathPattern	<< ast.NamePath(X[0], X[2], false) >>`,
+		Id:         "Pattern",
+		NTType:     12,
 		Index:      44,
-		NumSymbols: 2,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NamePath(X[0], X[2], false)
+		},
+	},
+	ProdTabEntry{
+		String:     `Pattern : id "=" "shortestPath" "(" PathPattern ")"	<< ast.NamePath(X[0], X[4], true) >>`,
+		Id:         "Pattern",
+		NTType:     12,
+		Index:      45,
+		NumSymbols: 6,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NamePath(X[0], X[4], true)
+		},
+	},
+	ProdTabEntry{
+		String:     `PathPattern : PathPattern Edge Node	<< ast.AppendPathSegment(X[0], X[1], X[2]) >>`,
+		Id:         "PathPattern",
+		NTType:     13,
+		Index:      46,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.AppendPathSegment(X[0], X[1], X[2])
+		},
+	},
+	ProdTabEntry{
+		String:     `PathPattern : Node	<< ast.NewPathPattern(X[0]) >>`,
+		Id:         "PathPattern",
+		NTType:     13,
+		Index:      47,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewPathPattern(X[0])
+		},
+	},
+	ProdTabEntry{
+		String:     `Node : "(" id ":" upid ")"	<< ast.NewNodeLabeled(X[1], X[3]) >>`,
+		Id:         "Node",
+		NTType:     14,
+		Index:      48,
+		NumSymbols: 5,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewNodeLabeled(X[1], X[3])
+		},
+	},
+	ProdTabEntry{
+		String:     `Node : "(" id ":" upid PropertyMap ")"	<< ast.NewNodeLabeledWithProperties(X[1], X[3], X[4]) >>`,
+		Id:         "Node",
+		NTType:     14,
+		Index:      49,
+		NumSymbols: 6,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewNodeLabeledWithProperties(X[1], X[3], X[4])
+		},
+	},
+	ProdTabEntry{
+		String:     `Node : "(" id ")"	<< ast.NewNodeVar(X[1]) >>`,
+		Id:         "Node",
+		NTType:     14,
+		Index:      50,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewNodeVar(X[1])
+		},
+	},
+	ProdTabEntry{
+		String:     `Node : "(" id PropertyMap ")"	<< ast.NewNodeVarWithProperties(X[1], X[2]) >>`,
+		Id:         "Node",
+		NTType:     14,
+		Index:      51,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewNodeVarWithProperties(X[1], X[2])
+		},
+	},
+	ProdTabEntry{
+		String:     `Node : "(" ":" upid ")"	<< ast.NewNodeLabeledAnon(X[2], nil) >>`,
+		Id:         "Node",
+		NTType:     14,
+		Index:      52,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewNodeLabeledAnon(X[2], nil)
+		},
+	},
+	ProdTabEntry{
+		String:     `Node : "(" ":" upid PropertyMap ")"	<< ast.NewNodeLabeledAnon(X[2], X[3]) >>`,
+		Id:         "Node",
+		NTType:     14,
+		Index:      53,
+		NumSymbols: 5,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewNodeLabeledAnon(X[2], X[3])
+		},
+	},
+	ProdTabEntry{
+		String:     `Node : "(" ")"	<< ast.NewNodeAnon() >>`,
+		Id:         "Node",
+		NTType:     14,
+		Index:      54,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewNodeAnon()
+		},
+	},
+	ProdTabEntry{
+		String:     `Node : "(" PropertyMap ")"	<< ast.NewNodeAnonWithProperties(X[1]) >>`,
+		Id:         "Node",
+		NTType:     14,
+		Index:      55,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewNodeAnonWithProperties(X[1])
+		},
+	},
+	ProdTabEntry{
+		String:     `Hops : "*" int "." "." int	<< ast.NewHops(X[1], X[4]) >>`,
+		Id:         "Hops",
+		NTType:     15,
+		Index:      56,
+		NumSymbols: 5,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewHops(X[1], X[4])
+		},
+	},
+	ProdTabEntry{
+		String:     `Hops : "*" int	<< ast.NewHops(X[1], X[1]) >>`,
+		Id:         "Hops",
+		NTType:     15,
+		Index:      57,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewHops(X[1], X[1])
+		},
+	},
+	ProdTabEntry{
+		String:     `Hops : "*"	<< ast.NewHops(nil, nil) >>`,
+		Id:         "Hops",
+		NTType:     15,
+		Index:      58,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewHops(nil, nil)
+		},
+	},
+	ProdTabEntry{
+		String:     `PropertyMap : "{" PropertyEntries "}"	<< X[1], nil >>`,
+		Id:         "PropertyMap",
+		NTType:     16,
+		Index:      59,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[1], nil
+		},
+	},
+	ProdTabEntry{
+		String:     `PropertyEntries : PropertyEntry	<< ast.NewPropertyEntries(X[0]) >>`,
+		Id:         "PropertyEntries",
+		NTType:     17,
+		Index:      60,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewPropertyEntries(X[0])
+		},
+	},
+	ProdTabEntry{
+		String:     `PropertyEntries : PropertyEntries "," PropertyEntry	<< ast.AppendPropertyEntry(X[0], X[2]) >>`,
+		Id:         "PropertyEntries",
+		NTType:     17,
+		Index:      61,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.AppendPropertyEntry(X[0], X[2])
+		},
+	},
+	ProdTabEntry{
+		String:     `PropertyEntry : id ":" Value	<< ast.NewPropertyEntry(X[0], X[2]) >>`,
+		Id:         "PropertyEntry",
+		NTType:     18,
+		Index:      62,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewPropertyEntry(X[0], X[2])
+		},
+	},
+	ProdTabEntry{
+		String:     `Edge : "-" "[" ":" RelTypes "]" "-" ">"	<< ast.NewEdgeForward(X[3]) >>`,
+		Id:         "Edge",
+		NTType:     19,
+		Index:      63,
+		NumSymbols: 7,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewEdgeForward(X[3])
+		},
+	},
+	ProdTabEntry{
+		String:     `Edge : "-" "[" ":" RelTypes "*" int "." "." int "]" "-" ">"	<< ast.NewEdgeMultiHopForward(X[3], X[5], X[8]) >>`,
+		Id:         "Edge",
+		NTType:     19,
+		Index:      64,
+		NumSymbols: 12,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewEdgeMultiHopForward(X[3], X[5], X[8])
+		},
+	},
+	ProdTabEntry{
+		String:     `Edge : "-" "[" ":" RelTypes "*" int "]" "-" ">"	<< ast.NewEdgeMultiHopForward(X[3], X[5], X[5]) >>`,
+		Id:         "Edge",
+		NTType:     19,
+		Index:      65,
+		NumSymbols: 9,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewEdgeMultiHopForward(X[3], X[5], X[5])
+		},
+	},
+	ProdTabEntry{
+		String:     `Edge : "-" "[" "*" int "." "." int "]" "-" ">"	<< ast.NewEdgeMultiHopForward(nil, X[3], X[6]) >>`,
+		Id:         "Edge",
+		NTType:     19,
+		Index:      66,
+		NumSymbols: 10,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewEdgeMultiHopForward(nil, X[3], X[6])
+		},
+	},
+	ProdTabEntry{
+		String:     `Edge : "-" "[" "*" int "]" "-" ">"	<< ast.NewEdgeMultiHopForward(nil, X[3], X[3]) >>`,
+		Id:         "Edge",
+		NTType:     19,
+		Index:      67,
+		NumSymbols: 7,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewEdgeMultiHopForward(nil, X[3], X[3])
+		},
+	},
+	ProdTabEntry{
+		String:     `Edge : "-" "[" ":" RelTypes "*" "]" "-" ">"	<< ast.NewEdgeMultiHopForward(X[3], nil, nil) >>`,
+		Id:         "Edge",
+		NTType:     19,
+		Index:      68,
+		NumSymbols: 8,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewEdgeMultiHopForward(X[3], nil, nil)
+		},
+	},
+	ProdTabEntry{
+		String:     `Edge : "-" "[" "*" "]" "-" ">"	<< ast.NewEdgeMultiHopForward(nil, nil, nil) >>`,
+		Id:         "Edge",
+		NTType:     19,
+		Index:      69,
+		NumSymbols: 6,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewEdgeMultiHopForward(nil, nil, nil)
+		},
+	},
+	ProdTabEntry{
+		String:     `Edge : "<" "-" "[" ":" RelTypes "]" "-"	<< ast.NewEdgeBackward(X[4]) >>`,
+		Id:         "Edge",
+		NTType:     19,
+		Index:      70,
+		NumSymbols: 7,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewEdgeBackward(X[4])
+		},
+	},
+	ProdTabEntry{
+		String:     `Edge : "<" "-" "[" ":" RelTypes "*" int "." "." int "]" "-"	<< ast.NewEdgeMultiHopBackward(X[4], X[6], X[9]) >>`,
+		Id:         "Edge",
+		NTType:     19,
+		Index:      71,
+		NumSymbols: 12,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewEdgeMultiHopBackward(X[4], X[6], X[9])
+		},
+	},
+	ProdTabEntry{
+		String:     `Edge : "<" "-" "[" ":" RelTypes "*" int "]" "-"	<< ast.NewEdgeMultiHopBackward(X[4], X[6], X[6]) >>`,
+		Id:         "Edge",
+		NTType:     19,
+		Index:      72,
+		NumSymbols: 9,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewEdgeMultiHopBackward(X[4], X[6], X[6])
+		},
+	},
+	ProdTabEntry{
+		String:     `Edge : "<" "-" "[" "*" int "." "." int "]" "-"	<< ast.NewEdgeMultiHopBackward(nil, X[4], X[7]) >>`,
+		Id:         "Edge",
+		NTType:     19,
+		Index:      73,
+		NumSymbols: 10,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewEdgeMultiHopBackward(nil, X[4], X[7])
+		},
+	},
+	ProdTabEntry{
+		String:     `Edge : "<" "-" "[" "*" int "]" "-"	<< ast.NewEdgeMultiHopBackward(nil, X[4], X[4]) >>`,
+		Id:         "Edge",
+		NTType:     19,
+		Index:      74,
+		NumSymbols: 7,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewEdgeMultiHopBackward(nil, X[4], X[4])
+		},
+	},
+	ProdTabEntry{
+		String:     `Edge : "<" "-" "[" ":" RelTypes "*" "]" "-"	<< ast.NewEdgeMultiHopBackward(X[4], nil, nil) >>`,
+		Id:         "Edge",
+		NTType:     19,
+		Index:      75,
+		NumSymbols: 8,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewEdgeMultiHopBackward(X[4], nil, nil)
+		},
+	},
+	ProdTabEntry{
+		String:     `Edge : "<" "-" "[" "*" "]" "-"	<< ast.NewEdgeMultiHopBackward(nil, nil, nil) >>`,
+		Id:         "Edge",
+		NTType:     19,
+		Index:      76,
+		NumSymbols: 6,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewEdgeMultiHopBackward(nil, nil, nil)
+		},
+	},
+	ProdTabEntry{
+		String:     `Edge : "-" "[" ":" RelTypes "]" "-"	<< ast.NewEdgeUndirected(X[3]) >>`,
+		Id:         "Edge",
+		NTType:     19,
+		Index:      77,
+		NumSymbols: 6,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewEdgeUndirected(X[3])
+		},
+	},
+	ProdTabEntry{
+		String:     `Edge : "-" "[" ":" RelTypes "*" int "." "." int "]" "-"	<< ast.NewEdgeMultiHopUndirected(X[3], X[5], X[8]) >>`,
+		Id:         "Edge",
+		NTType:     19,
+		Index:      78,
+		NumSymbols: 11,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewEdgeMultiHopUndirected(X[3], X[5], X[8])
+		},
+	},
+	ProdTabEntry{
+		String:     `Edge : "-" "[" ":" RelTypes "*" int "]" "-"	<< ast.NewEdgeMultiHopUndirected(X[3], X[5], X[5]) >>`,
+		Id:         "Edge",
+		NTType:     19,
+		Index:      79,
+		NumSymbols: 8,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewEdgeMultiHopUndirected(X[3], X[5], X[5])
+		},
+	},
+	ProdTabEntry{
+		String:     `Edge : "-" "[" "*" int "." "." int "]" "-"	<< ast.NewEdgeMultiHopUndirected(nil, X[3], X[6]) >>`,
+		Id:         "Edge",
+		NTType:     19,
+		Index:      80,
+		NumSymbols: 9,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewEdgeMultiHopUndirected(nil, X[3], X[6])
+		},
+	},
+	ProdTabEntry{
+		String:     `Edge : "-" "[" "*" int "]" "-"	<< ast.NewEdgeMultiHopUndirected(nil, X[3], X[3]) >>`,
+		Id:         "Edge",
+		NTType:     19,
+		Index:      81,
+		NumSymbols: 6,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewEdgeMultiHopUndirected(nil, X[3], X[3])
+		},
+	},
+	ProdTabEntry{
+		String:     `Edge : "-" "[" ":" RelTypes "*" "]" "-"	<< ast.NewEdgeMultiHopUndirected(X[3], nil, nil) >>`,
+		Id:         "Edge",
+		NTType:     19,
+		Index:      82,
+		NumSymbols: 7,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewEdgeMultiHopUndirected(X[3], nil, nil)
+		},
+	},
+	ProdTabEntry{
+		String:     `Edge : "-" "[" "*" "]" "-"	<< ast.NewEdgeMultiHopUndirected(nil, nil, nil) >>`,
+		Id:         "Edge",
+		NTType:     19,
+		Index:      83,
+		NumSymbols: 5,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewEdgeMultiHopUndirected(nil, nil, nil)
+		},
+	},
+	ProdTabEntry{
+		String:     `Edge : "-" "[" id ":" RelTypes "]" "-" ">"	<< ast.NewNamedEdge("->", X[2], X[4]) >>`,
+		Id:         "Edge",
+		NTType:     19,
+		Index:      84,
+		NumSymbols: 8,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewNamedEdge("->", X[2], X[4])
+		},
+	},
+	ProdTabEntry{
+		String:     `Edge : "<" "-" "[" id ":" RelTypes "]" "-"	<< ast.NewNamedEdge("<-", X[3], X[5]) >>`,
+		Id:         "Edge",
+		NTType:     19,
+		Index:      85,
+		NumSymbols: 8,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewNamedEdge("<-", X[3], X[5])
+		},
+	},
+	ProdTabEntry{
+		String:     `Edge : "-" "[" id ":" RelTypes "]" "-"	<< ast.NewNamedEdge("-", X[2], X[4]) >>`,
+		Id:         "Edge",
+		NTType:     19,
+		Index:      86,
+		NumSymbols: 7,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewNamedEdge("-", X[2], X[4])
+		},
+	},
+	ProdTabEntry{
+		String:     `Edge : "-" "[" id "]" "-" ">"	<< ast.NewNamedEdge("->", X[2], nil) >>`,
+		Id:         "Edge",
+		NTType:     19,
+		Index:      87,
+		NumSymbols: 6,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewNamedEdge("->", X[2], nil)
+		},
+	},
+	ProdTabEntry{
+		String:     `Edge : "<" "-" "[" id "]" "-"	<< ast.NewNamedEdge("<-", X[3], nil) >>`,
+		Id:         "Edge",
+		NTType:     19,
+		Index:      88,
+		NumSymbols: 6,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewNamedEdge("<-", X[3], nil)
+		},
+	},
+	ProdTabEntry{
+		String:     `Edge : "-" "[" id "]" "-"	<< ast.NewNamedEdge("-", X[2], nil) >>`,
+		Id:         "Edge",
+		NTType:     19,
+		Index:      89,
+		NumSymbols: 5,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewNamedEdge("-", X[2], nil)
+		},
+	},
+	ProdTabEntry{
+		String:     `Edge : "-" "[" id ":" RelTypes Hops "]" "-" ">"	<< ast.NewNamedMultiHopEdge("->", X[2], X[4], X[5]) >>`,
+		Id:         "Edge",
+		NTType:     19,
+		Index:      90,
+		NumSymbols: 9,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewNamedMultiHopEdge("->", X[2], X[4], X[5])
+		},
+	},
+	ProdTabEntry{
+		String:     `Edge : "<" "-" "[" id ":" RelTypes Hops "]" "-"	<< ast.NewNamedMultiHopEdge("<-", X[3], X[5], X[6]) >>`,
+		Id:         "Edge",
+		NTType:     19,
+		Index:      91,
+		NumSymbols: 9,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewNamedMultiHopEdge("<-", X[3], X[5], X[6])
+		},
+	},
+	ProdTabEntry{
+		String:     `Edge : "-" "[" id ":" RelTypes Hops "]" "-"	<< ast.NewNamedMultiHopEdge("-", X[2], X[4], X[5]) >>`,
+		Id:         "Edge",
+		NTType:     19,
+		Index:      92,
+		NumSymbols: 8,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewNamedMultiHopEdge("-", X[2], X[4], X[5])
+		},
+	},
+	ProdTabEntry{
+		String:     `Edge : "-" "[" id Hops "]" "-" ">"	<< ast.NewNamedMultiHopEdge("->", X[2], nil, X[3]) >>`,
+		Id:         "Edge",
+		NTType:     19,
+		Index:      93,
+		NumSymbols: 7,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewNamedMultiHopEdge("->", X[2], nil, X[3])
+		},
+	},
+	ProdTabEntry{
+		String:     `Edge : "<" "-" "[" id Hops "]" "-"	<< ast.NewNamedMultiHopEdge("<-", X[3], nil, X[4]) >>`,
+		Id:         "Edge",
+		NTType:     19,
+		Index:      94,
+		NumSymbols: 7,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewNamedMultiHopEdge("<-", X[3], nil, X[4])
+		},
+	},
+	ProdTabEntry{
+		String:     `Edge : "-" "[" id Hops "]" "-"	<< ast.NewNamedMultiHopEdge("-", X[2], nil, X[3]) >>`,
+		Id:         "Edge",
+		NTType:     19,
+		Index:      95,
+		NumSymbols: 6,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewNamedMultiHopEdge("-", X[2], nil, X[3])
+		},
+	},
+	ProdTabEntry{
+		String:     `Edge : "-" "[" "]" "-" ">"	<< ast.NewEdgeAnyForward() >>`,
+		Id:         "Edge",
+		NTType:     19,
+		Index:      96,
+		NumSymbols: 5,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewEdgeAnyForward()
+		},
+	},
+	ProdTabEntry{
+		String:     `Edge : "-" "[" "]" "-"	<< ast.NewEdgeAny() >>`,
+		Id:         "Edge",
+		NTType:     19,
+		Index:      97,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewEdgeAny()
+		},
+	},
+	ProdTabEntry{
+		String:     `RelTypes : id	<< ast.NewRelTypes(X[0]) >>`,
+		Id:         "RelTypes",
+		NTType:     20,
+		Index:      98,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewRelTypes(X[0])
+		},
+	},
+	ProdTabEntry{
+		String:     `RelTypes : RelTypes "|" id	<< ast.AppendRelType(X[0], X[2]) >>`,
+		Id:         "RelTypes",
+		NTType:     20,
+		Index:      99,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.AppendRelType(X[0], X[2])
+		},
+	},
+	ProdTabEntry{
+		String:     `WhereClause : "WHERE" OrExpr	<< ast.NewWhereClause(X[1]) >>`,
+		Id:         "WhereClause",
+		NTType:     21,
+		Index:      100,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewWhereClause(X[1])
+		},
+	},
+	ProdTabEntry{
+		String:     `OrExpr : OrExpr "OR" AndExpr	<< ast.NewBinaryExpr("OR", X[0], X[2]) >>`,
+		Id:         "OrExpr",
+		NTType:     22,
+		Index:      101,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewBinaryExpr("OR", X[0], X[2])
+		},
+	},
+	ProdTabEntry{
+		String:     `OrExpr : AndExpr	<<  >>`,
+		Id:         "OrExpr",
+		NTType:     22,
+		Index:      102,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String:     `AndExpr : AndExpr "AND" NotExpr	<< ast.NewBinaryExpr("AND", X[0], X[2]) >>`,
+		Id:         "AndExpr",
+		NTType:     23,
+		Index:      103,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewBinaryExpr("AND", X[0], X[2])
+		},
+	},
+	ProdTabEntry{
+		String:     `AndExpr : NotExpr	<<  >>`,
+		Id:         "AndExpr",
+		NTType:     23,
+		Index:      104,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String:     `NotExpr : "NOT" NotExpr	<< ast.NewNotExpr(X[1]) >>`,
+		Id:         "NotExpr",
+		NTType:     24,
+		Index:      105,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewNotExpr(X[1])
+		},
+	},
+	ProdTabEntry{
+		String:     `NotExpr : Predicate	<<  >>`,
+		Id:         "NotExpr",
+		NTType:     24,
+		Index:      106,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String:     `Predicate : Value CompOp Value	<< ast.NewComparison(X[1], X[0], X[2]) >>`,
+		Id:         "Predicate",
+		NTType:     25,
+		Index:      107,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewComparison(X[1], X[0], X[2])
+		},
+	},
+	ProdTabEntry{
+		String:     `Predicate : Value "IN" Value	<< ast.NewComparison(X[1], X[0], X[2]) >>`,
+		Id:         "Predicate",
+		NTType:     25,
+		Index:      108,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewComparison(X[1], X[0], X[2])
+		},
+	},
+	ProdTabEntry{
+		String:     `Predicate : Value "STARTS" "WITH" Value	<< ast.NewStringComparison("STARTS WITH", X[0], X[3]) >>`,
+		Id:         "Predicate",
+		NTType:     25,
+		Index:      109,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewStringComparison("STARTS WITH", X[0], X[3])
+		},
+	},
+	ProdTabEntry{
+		String:     `Predicate : Value "ENDS" "WITH" Value	<< ast.NewStringComparison("ENDS WITH", X[0], X[3]) >>`,
+		Id:         "Predicate",
+		NTType:     25,
+		Index:      110,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewStringComparison("ENDS WITH", X[0], X[3])
+		},
+	},
+	ProdTabEntry{
+		String:     `Predicate : Value "CONTAINS" Value	<< ast.NewComparison(X[1], X[0], X[2]) >>`,
+		Id:         "Predicate",
+		NTType:     25,
+		Index:      111,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewComparison(X[1], X[0], X[2])
+		},
+	},
+	ProdTabEntry{
+		String:     `Predicate : Value "=~" Value	<< ast.NewComparison(X[1], X[0], X[2]) >>`,
+		Id:         "Predicate",
+		NTType:     25,
+		Index:      112,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewComparison(X[1], X[0], X[2])
+		},
+	},
+	ProdTabEntry{
+		String:     `Predicate : Value "IS" "NULL"	<< ast.NewIsNull(X[0], false) >>`,
+		Id:         "Predicate",
+		NTType:     25,
+		Index:      113,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewIsNull(X[0], false)
+		},
+	},
+	ProdTabEntry{
+		String:     `Predicate : Value "IS" "NOT" "NULL"	<< ast.NewIsNull(X[0], true) >>`,
+		Id:         "Predicate",
+		NTType:     25,
+		Index:      114,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewIsNull(X[0], true)
+		},
+	},
+	ProdTabEntry{
+		String:     `Predicate : "(" OrExpr ")"	<< X[1], nil >>`,
+		Id:         "Predicate",
+		NTType:     25,
+		Index:      115,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[1], nil
+		},
+	},
+	ProdTabEntry{
+		String:     `Predicate : PathPattern Edge Node	<< ast.NewPatternPredicate(X[0], X[1], X[2]) >>`,
+		Id:         "Predicate",
+		NTType:     25,
+		Index:      116,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewPatternPredicate(X[0], X[1], X[2])
+		},
+	},
+	ProdTabEntry{
+		String:     `Predicate : "EXISTS" "{" "MATCH" PatternList WhereClause "}"	<< ast.NewExistsExpr(X[3], X[4]) >>`,
+		Id:         "Predicate",
+		NTType:     25,
+		Index:      117,
+		NumSymbols: 6,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewExistsExpr(X[3], X[4])
+		},
+	},
+	ProdTabEntry{
+		String:     `Predicate : "EXISTS" "{" "MATCH" PatternList "}"	<< ast.NewExistsExpr(X[3], nil) >>`,
+		Id:         "Predicate",
+		NTType:     25,
+		Index:      118,
+		NumSymbols: 5,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewExistsExpr(X[3], nil)
+		},
+	},
+	ProdTabEntry{
+		String:     `CompOp : "="	<<  >>`,
+		Id:         "CompOp",
+		NTType:     26,
+		Index:      119,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String:     `CompOp : "<>"	<<  >>`,
+		Id:         "CompOp",
+		NTType:     26,
+		Index:      120,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String:     `CompOp : "<"	<<  >>`,
+		Id:         "CompOp",
+		NTType:     26,
+		Index:      121,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String:     `CompOp : ">"	<<  >>`,
+		Id:         "CompOp",
+		NTType:     26,
+		Index:      122,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String:     `CompOp : "<="	<<  >>`,
+		Id:         "CompOp",
+		NTType:     26,
+		Index:      123,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String:     `CompOp : ">="	<<  >>`,
+		Id:         "CompOp",
+		NTType:     26,
+		Index:      124,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String:     `Value : id "." id	<< ast.NewPropertyRef(X[0], X[2]) >>`,
+		Id:         "Value",
+		NTType:     27,
+		Index:      125,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewPropertyRef(X[0], X[2])
+		},
+	},
+	ProdTabEntry{
+		String:     `Value : id "(" id ")"	<< ast.NewFunctionCall(X[0], X[2]) >>`,
+		Id:         "Value",
+		NTType:     27,
+		Index:      126,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewFunctionCall(X[0], X[2])
+		},
+	},
+	ProdTabEntry{
+		String:     `Value : id "(" id "," Literal ")"	<< ast.NewFunctionCallWithArg(X[0], X[2], X[4]) >>`,
+		Id:         "Value",
+		NTType:     27,
+		Index:      127,
+		NumSymbols: 6,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewFunctionCallWithArg(X[0], X[2], X[4])
+		},
+	},
+	ProdTabEntry{
+		String:     `Value : id	<< ast.NewVariableRef(X[0]) >>`,
+		Id:         "Value",
+		NTType:     27,
+		Index:      128,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewVariableRef(X[0])
+		},
+	},
+	ProdTabEntry{
+		String:     `Value : "[" ValueList "]"	<< ast.NewListLiteral(X[1]) >>`,
+		Id:         "Value",
+		NTType:     27,
+		Index:      129,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewListLiteral(X[1])
+		},
+	},
+	ProdTabEntry{
+		String:     `Value : "[" "]"	<< ast.NewListLiteral(nil) >>`,
+		Id:         "Value",
+		NTType:     27,
+		Index:      130,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewListLiteral(nil)
+		},
+	},
+	ProdTabEntry{
+		String:     `Value : Literal	<<  >>`,
+		Id:         "Value",
+		NTType:     27,
+		Index:      131,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String:     `ValueList : Value	<< ast.NewValueList(X[0]) >>`,
+		Id:         "ValueList",
+		NTType:     28,
+		Index:      132,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewValueList(X[0])
+		},
+	},
+	ProdTabEntry{
+		String:     `ValueList : ValueList "," Value	<< ast.AppendValue(X[0], X[2]) >>`,
+		Id:         "ValueList",
+		NTType:     28,
+		Index:      133,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.AppendValue(X[0], X[2])
+		},
+	},
+	ProdTabEntry{
+		String:     `Literal : string	<< ast.NewStringLiteral(X[0]) >>`,
+		Id:         "Literal",
+		NTType:     29,
+		Index:      134,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewStringLiteral(X[0])
+		},
+	},
+	ProdTabEntry{
+		String:     `Literal : int	<< ast.NewIntLiteral(X[0], false) >>`,
+		Id:         "Literal",
+		NTType:     29,
+		Index:      135,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewIntLiteral(X[0], false)
+		},
+	},
+	ProdTabEntry{
+		String:     `Literal : "-" int	<< ast.NewIntLiteral(X[1], true) >>`,
+		Id:         "Literal",
+		NTType:     29,
+		Index:      136,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewIntLiteral(X[1], true)
+		},
+	},
+	ProdTabEntry{
+		String:     `Literal : int "." int	<< ast.NewFloatLiteral(X[0], X[2], false) >>`,
+		Id:         "Literal",
+		NTType:     29,
+		Index:      137,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewFloatLiteral(X[0], X[2], false)
+		},
+	},
+	ProdTabEntry{
+		String:     `Literal : "-" int "." int	<< ast.NewFloatLiteral(X[1], X[3], true) >>`,
+		Id:         "Literal",
+		NTType:     29,
+		Index:      138,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewFloatLiteral(X[1], X[3], true)
+		},
+	},
+	ProdTabEntry{
+		String:     `Literal : "TRUE"	<< ast.NewBoolLiteral(true) >>`,
+		Id:         "Literal",
+		NTType:     29,
+		Index:      139,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewBoolLiteral(true)
+		},
+	},
+	ProdTabEntry{
+		String:     `Literal : "true"	<< ast.NewBoolLiteral(true) >>`,
+		Id:         "Literal",
+		NTType:     29,
+		Index:      140,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewBoolLiteral(true)
+		},
+	},
+	ProdTabEntry{
+		String:     `Literal : "FALSE"	<< ast.NewBoolLiteral(false) >>`,
+		Id:         "Literal",
+		NTType:     29,
+		Index:      141,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewBoolLiteral(false)
+		},
+	},
+	ProdTabEntry{
+		String:     `Literal : "false"	<< ast.NewBoolLiteral(false) >>`,
+		Id:         "Literal",
+		NTType:     29,
+		Index:      142,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewBoolLiteral(false)
+		},
+	},
+	ProdTabEntry{
+		String:     `Literal : param	<< ast.NewParameter(X[0]) >>`,
+		Id:         "Literal",
+		NTType:     29,
+		Index:      143,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewParameter(X[0])
+		},
+	},
+	ProdTabEntry{
+		String:     `Literal : "NULL"	<< ast.NewNullLiteral() >>`,
+		Id:         "Literal",
+		NTType:     29,
+		Index:      144,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewNullLiteral()
+		},
+	},
+	ProdTabEntry{
+		String:     `Literal : "null"	<< ast.NewNullLiteral() >>`,
+		Id:         "Literal",
+		NTType:     29,
+		Index:      145,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewNullLiteral()
+		},
+	},
+	ProdTabEntry{
+		String:     `ReturnClause : "RETURN" ReturnItems	<< ast.NewReturnClause(X[1]) >>`,
+		Id:         "ReturnClause",
+		NTType:     30,
+		Index:      146,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewReturnClause(X[1])
+		},
+	},
+	ProdTabEntry{
+		String:     `ReturnClause : "RETURN" "DISTINCT" ReturnItems	<< ast.NewReturnClauseDistinct(X[2]) >>`,
+		Id:         "ReturnClause",
+		NTType:     30,
+		Index:      147,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewReturnClauseDistinct(X[2])
+		},
+	},
+	ProdTabEntry{
+		String:     `ReturnItems : ReturnItem	<< ast.NewReturnItems(X[0]) >>`,
+		Id:         "ReturnItems",
+		NTType:     31,
+		Index:      148,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewReturnItems(X[0])
+		},
+	},
+	ProdTabEntry{
+		String:     `ReturnItems : ReturnItems "," ReturnItem	<< ast.AppendReturnItem(X[0], X[2]) >>`,
+		Id:         "ReturnItems",
+		NTType:     31,
+		Index:      149,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.AppendReturnItem(X[0], X[2])
+		},
+	},
+	ProdTabEntry{
+		String:     `ReturnItem : FuncName "(" id ")" "AS" id	<< ast.NewReturnCall(X[0], X[2], nil, X[5]) >>`,
+		Id:         "ReturnItem",
+		NTType:     32,
+		Index:      150,
+		NumSymbols: 6,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewReturnCall(X[0], X[2], nil, X[5])
+		},
+	},
+	ProdTabEntry{
+		String:     `ReturnItem : FuncName "(" id ")"	<< ast.NewReturnCall(X[0], X[2], nil, nil) >>`,
+		Id:         "ReturnItem",
+		NTType:     32,
+		Index:      151,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewReturnCall(X[0], X[2], nil, nil)
+		},
+	},
+	ProdTabEntry{
+		String:     `ReturnItem : FuncName "(" id "." id ")" "AS" id	<< ast.NewReturnCall(X[0], X[2], X[4], X[7]) >>`,
+		Id:         "ReturnItem",
+		NTType:     32,
+		Index:      152,
+		NumSymbols: 8,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewReturnCall(X[0], X[2], X[4], X[7])
+		},
+	},
+	ProdTabEntry{
+		String:     `ReturnItem : FuncName "(" id "." id ")"	<< ast.NewReturnCall(X[0], X[2], X[4], nil) >>`,
+		Id:         "ReturnItem",
+		NTType:     32,
+		Index:      153,
+		NumSymbols: 6,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewReturnCall(X[0], X[2], X[4], nil)
+		},
+	},
+	ProdTabEntry{
+		String:     `ReturnItem : FuncName "(" "DISTINCT" id ")" "AS" id	<< ast.NewReturnDistinctCall(X[0], X[3], nil, X[6]) >>`,
+		Id:         "ReturnItem",
+		NTType:     32,
+		Index:      154,
+		NumSymbols: 7,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewReturnDistinctCall(X[0], X[3], nil, X[6])
+		},
+	},
+	ProdTabEntry{
+		String:     `ReturnItem : FuncName "(" "DISTINCT" id ")"	<< ast.NewReturnDistinctCall(X[0], X[3], nil, nil) >>`,
+		Id:         "ReturnItem",
+		NTType:     32,
+		Index:      155,
+		NumSymbols: 5,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewReturnDistinctCall(X[0], X[3], nil, nil)
+		},
+	},
+	ProdTabEntry{
+		String:     `ReturnItem : FuncName "(" "DISTINCT" id "." id ")" "AS" id	<< ast.NewReturnDistinctCall(X[0], X[3], X[5], X[8]) >>`,
+		Id:         "ReturnItem",
+		NTType:     32,
+		Index:      156,
+		NumSymbols: 9,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewReturnDistinctCall(X[0], X[3], X[5], X[8])
+		},
+	},
+	ProdTabEntry{
+		String:     `ReturnItem : FuncName "(" "DISTINCT" id "." id ")"	<< ast.NewReturnDistinctCall(X[0], X[3], X[5], nil) >>`,
+		Id:         "ReturnItem",
+		NTType:     32,
+		Index:      157,
+		NumSymbols: 7,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewReturnDistinctCall(X[0], X[3], X[5], nil)
+		},
+	},
+	ProdTabEntry{
+		String:     `ReturnItem : FuncName "(" id "," Literal ")" "AS" id	<< ast.NewReturnCallWithArg(X[0], X[2], X[4], X[7]) >>`,
+		Id:         "ReturnItem",
+		NTType:     32,
+		Index:      158,
+		NumSymbols: 8,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewReturnCallWithArg(X[0], X[2], X[4], X[7])
+		},
+	},
+	ProdTabEntry{
+		String:     `ReturnItem : FuncName "(" id "," Literal ")"	<< ast.NewReturnCallWithArg(X[0], X[2], X[4], nil) >>`,
+		Id:         "ReturnItem",
+		NTType:     32,
+		Index:      159,
+		NumSymbols: 6,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewReturnCallWithArg(X[0], X[2], X[4], nil)
+		},
+	},
+	ProdTabEntry{
+		String:     `ReturnItem : FuncName "(" "*" ")" "AS" id	<< ast.NewReturnCall(X[0], nil, nil, X[5]) >>`,
+		Id:         "ReturnItem",
+		NTType:     32,
+		Index:      160,
+		NumSymbols: 6,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewReturnCall(X[0], nil, nil, X[5])
+		},
+	},
+	ProdTabEntry{
+		String:     `ReturnItem : FuncName "(" "*" ")"	<< ast.NewReturnCall(X[0], nil, nil, nil) >>`,
+		Id:         "ReturnItem",
+		NTType:     32,
+		Index:      161,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewReturnCall(X[0], nil, nil, nil)
+		},
+	},
+	ProdTabEntry{
+		String:     `ReturnItem : id "." id "AS" id	<< ast.NewReturnPropWithAlias(X[0], X[2], X[4]) >>`,
+		Id:         "ReturnItem",
+		NTType:     32,
+		Index:      162,
+		NumSymbols: 5,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewReturnPropWithAlias(X[0], X[2], X[4])
+		},
+	},
+	ProdTabEntry{
+		String:     `ReturnItem : id "." id	<< ast.NewReturnProp(X[0], X[2]) >>`,
+		Id:         "ReturnItem",
+		NTType:     32,
+		Index:      163,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewReturnProp(X[0], X[2])
+		},
+	},
+	ProdTabEntry{
+		String:     `ReturnItem : id	<< ast.NewReturnVar(X[0]) >>`,
+		Id:         "ReturnItem",
+		NTType:     32,
+		Index:      164,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewReturnVar(X[0])
+		},
+	},
+	ProdTabEntry{
+		String:     `FuncName : upid	<<  >>`,
+		Id:         "FuncName",
+		NTType:     33,
+		Index:      165,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String:     `FuncName : id	<<  >>`,
+		Id:         "FuncName",
+		NTType:     33,
+		Index:      166,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String:     `GroupByClause : "GROUP" "BY" GroupByItems	<< ast.NewGroupByClause(X[2]) >>`,
+		Id:         "GroupByClause",
+		NTType:     34,
+		Index:      167,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewGroupByClause(X[2])
+		},
+	},
+	ProdTabEntry{
+		String:     `GroupByItems : GroupByItem	<< ast.NewGroupByItems(X[0]) >>`,
+		Id:         "GroupByItems",
+		NTType:     35,
+		Index:      168,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewGroupByItems(X[0])
+		},
+	},
+	ProdTabEntry{
+		String:     `GroupByItems : GroupByItems "," GroupByItem	<< ast.AppendGroupByItem(X[0], X[2]) >>`,
+		Id:         "GroupByItems",
+		NTType:     35,
+		Index:      169,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.AppendGroupByItem(X[0], X[2])
+		},
+	},
+	ProdTabEntry{
+		String:     `GroupByItem : id "." id	<< ast.NewGroupByItem(X[0], X[2]) >>`,
+		Id:         "GroupByItem",
+		NTType:     36,
+		Index:      170,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewGroupByItem(X[0], X[2])
+		},
+	},
+	ProdTabEntry{
+		String:     `OrderByClause : "ORDER" "BY" OrderByItems	<< ast.NewOrderByClause(X[2]) >>`,
+		Id:         "OrderByClause",
+		NTType:     37,
+		Index:      171,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewOrderByClause(X[2])
+		},
+	},
+	ProdTabEntry{
+		String:     `OrderByItems : OrderByItem	<< ast.NewOrderByItems(X[0]) >>`,
+		Id:         "OrderByItems",
+		NTType:     38,
+		Index:      172,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewOrderByItems(X[0])
+		},
+	},
+	ProdTabEntry{
+		String:     `OrderByItems : OrderByItems "," OrderByItem	<< ast.AppendOrderByItem(X[0], X[2]) >>`,
+		Id:         "OrderByItems",
+		NTType:     38,
+		Index:      173,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.AppendOrderByItem(X[0], X[2])
+		},
+	},
+	ProdTabEntry{
+		String:     `OrderByItem : id "ASC"	<< ast.NewOrderByItemAsc(X[0]) >>`,
+		Id:         "OrderByItem",
+		NTType:     39,
+		Index:      174,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewOrderByItemAsc(X[0])
+		},
+	},
+	ProdTabEntry{
+		String:     `OrderByItem : id "DESC"	<< ast.NewOrderByItemDesc(X[0]) >>`,
+		Id:         "OrderByItem",
+		NTType:     39,
+		Index:      175,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewOrderByItemDesc(X[0])
+		},
+	},
+	ProdTabEntry{
+		String:     `OrderByItem : id	<< ast.NewOrderByItem(X[0]) >>`,
+		Id:         "OrderByItem",
+		NTType:     39,
+		Index:      176,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewOrderByItem(X[0])
+		},
+	},
+	ProdTabEntry{
+		String:     `LimitClause : "LIMIT" LimitValue	<< ast.NewLimitClause(X[1]) >>`,
+		Id:         "LimitClause",
+		NTType:     40,
+		Index:      177,
+		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.NewLimitClause(X[1])
 		},
 	},
+	ProdTabEntry{
+		String:     `LimitClause : "SKIP" LimitValue "LIMIT" LimitValue	<< ast.NewSkipLimitClause(X[1], X[3]) >>`,
+		Id:         "LimitClause",
+		NTType:     40,
+		Index:      178,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewSkipLimitClause(X[1], X[3])
+		},
+	},
+	ProdTabEntry{
+		String:     `LimitClause : "SKIP" LimitValue	<< ast.NewSkipLimitClause(X[1], nil) >>`,
+		Id:         "LimitClause",
+		NTType:     40,
+		Index:      179,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewSkipLimitClause(X[1], nil)
+		},
+	},
+	ProdTabEntry{
+		String:     `LimitValue : int	<< X[0], nil >>`,
+		Id:         "LimitValue",
+		NTType:     41,
+		Index:      180,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String:     `LimitValue : param	<< ast.NewParameter(X[0]) >>`,
+		Id:         "LimitValue",
+		NTType:     41,
+		Index:      181,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewParameter(X[0])
+		},
+	},
 }
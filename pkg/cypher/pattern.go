@@ -0,0 +1,971 @@
+package cypher
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/wouteroostervld/chainsaw/pkg/cypher/ast"
+)
+
+// patternJoins holds the SQL fragments produced by walking path patterns.
+// Entities get the aliases e1, e2, ... in the order they are bound; hop i
+// (1-based, counted across all patterns) joins graph_edges as g<i> or, for
+// variable-length hops, a recursive CTE paths<i> as p<i> (the suffix is
+// omitted for the first hop).
+type patternJoins struct {
+	vars     map[string]string  // Cypher variable -> entity or edge alias
+	scalars  map[string]string  // values passed on by WITH -> stage column
+	listVars map[string]bool    // scalars holding JSON arrays (COLLECT)
+	paths    map[string]pathVar // path variables of p = (...)
+	depths   map[string]string  // variables of variable-length relations -> CTE alias
+	entities []string           // entity aliases in binding order
+	rels     []string           // edge aliases bound to relationship variables
+	optional map[string]bool    // entities and edges joined inside an OPTIONAL MATCH group
+	labeled  map[string]bool    // alias/label filters already emitted
+	hops     int
+	joins    []sqlJoin
+	joinArgs []interface{} // bound by OPTIONAL MATCH ON clauses
+	ctes     []cte         // recursive and similarity CTE definitions
+	scope    []string      // CWD conditions
+	scopeArg []interface{}
+	conds    []string // label, relation type and depth conditions
+	condArgs []interface{}
+	distinct bool // variable-length hops can yield duplicate rows
+	tracking bool // recursive CTEs record the nodes they pass (path variables)
+	bound    int  // entity aliases handed out, including those of subqueries
+	opts     TranspileOptions
+
+	undirected bool                 // some hop reads the undirected_edges CTE
+	embeddings map[string]string    // similar() texts -> CTE of their embedding, shared by all stages
+	anon       map[*ast.Node]string // pattern nodes without a variable -> entity alias
+
+	// Constant filters that seed recursive CTEs, see walkSeed
+	seeds      map[string][]*ast.PropertyEntry // entity alias -> labels and properties of its pattern nodes
+	whereSeeds map[string][]*ast.PropertyEntry // variable -> equalities the WHERE in force requires
+	grouping   bool                            // joining an OPTIONAL MATCH group
+}
+
+// sqlJoin is one item of the FROM clause. Each ON condition records the
+// entity alias it ties the joined table to.
+type sqlJoin struct {
+	op    string // "FROM", "JOIN" or "CROSS JOIN"
+	table string // e.g. "graph_edges g2"
+	on    []joinCond
+	text  string   // pre-rendered item (OPTIONAL MATCH groups)
+	outer []string // aliases whose chunk and file joins the group refers to
+}
+
+type joinCond struct {
+	sql    string
+	entity string
+}
+
+// pathVar is a path bound to a variable. nodes renders the JSON array of
+// its entity ids in pattern order, length the number of relations.
+type pathVar struct {
+	nodes  string
+	length string
+}
+
+// hop records the aliases one edge of a pattern was joined with; left and
+// right are the entities in the order the pattern is written
+type hop struct {
+	left, right string
+	edge        string
+	varLength   bool
+}
+
+func (j sqlJoin) String() string {
+	if j.text != "" {
+		return j.text
+	}
+	if len(j.on) == 0 {
+		return j.op + " " + j.table
+	}
+	conds := make([]string, len(j.on))
+	for i, c := range j.on {
+		conds[i] = c.sql
+	}
+	return fmt.Sprintf("%s %s ON %s", j.op, j.table, strings.Join(conds, " AND "))
+}
+
+func newPatternJoins(opts TranspileOptions) *patternJoins {
+	return &patternJoins{
+		vars:     map[string]string{},
+		scalars:  map[string]string{},
+		listVars: map[string]bool{},
+		paths:    map[string]pathVar{},
+		depths:   map[string]string{},
+		optional: map[string]bool{},
+		labeled:  map[string]bool{},
+		opts:     opts,
+
+		embeddings: map[string]string{},
+		anon:       map[*ast.Node]string{},
+		seeds:      map[string][]*ast.PropertyEntry{},
+		whereSeeds: map[string][]*ast.PropertyEntry{},
+	}
+}
+
+// addOptional joins the patterns of an OPTIONAL MATCH as a single LEFT JOIN
+// group, so rows without a complete match are kept with NULLs instead of
+// being dropped. Every condition of the clause, including its WHERE, moves
+// into the group's ON clause; conditions that tie the group to entities
+// bound earlier must live there too, as SQLite does not let a nested join
+// refer to tables outside it.
+func (pj *patternJoins) addOptional(match *ast.MatchClause) error {
+	if len(pj.joins) == 0 {
+		return fmt.Errorf("OPTIONAL MATCH requires a preceding MATCH")
+	}
+
+	// Collect the group on fresh lists
+	joins, scope, scopeArg, conds, condArgs := pj.joins, pj.scope, pj.scopeArg, pj.conds, pj.condArgs
+	pj.joins, pj.scope, pj.scopeArg, pj.conds, pj.condArgs = nil, nil, nil, nil, nil
+	labeled := map[string]bool{}
+	for k := range pj.labeled {
+		labeled[k] = true
+	}
+	first, firstRel := len(pj.entities), len(pj.rels)
+
+	// The WHERE of the group only seeds the walks inside it
+	whereSeeds := pj.whereSeeds
+	pj.whereSeeds = map[string][]*ast.PropertyEntry{}
+	for v, filters := range whereSeeds {
+		pj.whereSeeds[v] = filters
+	}
+	pj.addWhereSeeds(match.Where)
+	pj.grouping = true
+	for _, pattern := range match.Patterns {
+		if err := pj.addPattern(pattern); err != nil {
+			return err
+		}
+	}
+	pj.grouping = false
+	pj.whereSeeds = whereSeeds
+
+	local := map[string]bool{}
+	for _, alias := range pj.entities[first:] {
+		local[alias] = true
+		pj.optional[alias] = true
+	}
+	for _, alias := range pj.rels[firstRel:] {
+		pj.optional[alias] = true
+	}
+
+	var on, inner []string
+	for i, j := range pj.joins {
+		var keep []joinCond
+		for _, c := range j.on {
+			if local[c.entity] && i > 0 {
+				keep = append(keep, c)
+			} else {
+				on = append(on, c.sql)
+			}
+		}
+		j.on = keep
+		if i == 0 {
+			inner = append(inner, j.table)
+		} else {
+			inner = append(inner, j.String())
+		}
+	}
+	inner = append(inner, chunkJoins(pj.entities[first:])...)
+	inner = append(inner, chunkJoins(pj.rels[firstRel:])...)
+
+	on = append(on, pj.scope...)
+	on = append(on, pj.conds...)
+	args := append(pj.scopeArg, pj.condArgs...)
+
+	if match.Where != nil {
+		cond, condArgs, err := pj.compileExpression(match.Where.Expr)
+		if err != nil {
+			return err
+		}
+		on = append(on, cond)
+		args = append(args, condArgs...)
+	}
+	if len(on) == 0 {
+		on = append(on, "1=1")
+	}
+
+	// The ON clause may read the files of entities bound earlier, so their
+	// chunk and file joins must come first
+	var outer []string
+	for _, alias := range append(append([]string{}, pj.entities[:first]...), pj.rels[:firstRel]...) {
+		if !pj.optional[alias] {
+			outer = append(outer, alias)
+		}
+	}
+
+	group := fmt.Sprintf("LEFT JOIN (\n  %s\n) ON %s", strings.Join(inner, "\n  "), strings.Join(on, "\n  AND "))
+	pj.joins = append(joins, sqlJoin{text: group, outer: outer})
+	pj.joinArgs = append(pj.joinArgs, args...)
+	pj.scope, pj.scopeArg, pj.conds, pj.condArgs = scope, scopeArg, conds, condArgs
+	pj.labeled = labeled
+	return nil
+}
+
+// addPattern joins a path pattern onto the entities bound so far, sharing
+// entities by variable name. Hops are walked outward from a node that is
+// already bound; a pattern sharing no variable with earlier ones starts at
+// the source of its first hop.
+func (pj *patternJoins) addPattern(pattern *ast.PathPattern) error {
+	for _, node := range pattern.Nodes {
+		if alias, ok := pj.lookup(node); ok && isRelationshipAlias(alias) {
+			return fmt.Errorf("%s is a relationship variable, not a node", node.Variable)
+		}
+		if _, ok := pj.scalars[node.Variable]; ok {
+			return fmt.Errorf("%s is a value passed on by WITH, not a node", node.Variable)
+		}
+		if _, ok := pj.paths[node.Variable]; ok {
+			return fmt.Errorf("%s is a path variable, not a node", node.Variable)
+		}
+		if _, ok := pj.depths[node.Variable]; ok {
+			return fmt.Errorf("%s is a relationship variable, not a node", node.Variable)
+		}
+	}
+	if pattern.Variable != "" {
+		if err := pj.checkPath(pattern); err != nil {
+			return err
+		}
+		pj.tracking = true
+		defer func() { pj.tracking = false }()
+	}
+
+	if len(pattern.Edges) == 0 {
+		node := pattern.Nodes[0]
+		alias, isNew := pj.bind(node, "")
+		if err := pj.addNode(alias, node); err != nil {
+			return err
+		}
+		if isNew && pj.opts.CWD != "" {
+			pj.scope = append(pj.scope, fileColumn(alias)+" LIKE ?")
+			pj.scopeArg = append(pj.scopeArg, cwdPattern(pj.opts.CWD))
+		}
+		if pattern.Variable != "" {
+			pj.paths[pattern.Variable] = pathVar{nodes: fmt.Sprintf("'[' || %s.id || ']'", alias), length: "0"}
+		}
+		return nil
+	}
+
+	hops := make([]hop, len(pattern.Edges))
+	done := make([]bool, len(pattern.Edges))
+	for n := range pattern.Edges {
+		next := -1
+		for i := range pattern.Edges {
+			if done[i] {
+				continue
+			}
+			_, leftBound := pj.lookup(pattern.Nodes[i])
+			_, rightBound := pj.lookup(pattern.Nodes[i+1])
+			if leftBound || rightBound {
+				next = i
+				break
+			}
+		}
+		if next < 0 {
+			if n > 0 {
+				return fmt.Errorf("cannot join the relations of the pattern in order")
+			}
+			next = 0
+		}
+		done[next] = true
+		h, err := pj.addHop(pattern.Nodes[next], pattern.Edges[next], pattern.Nodes[next+1])
+		if err != nil {
+			return err
+		}
+		hops[next] = h
+	}
+	if pattern.Variable != "" {
+		pj.bindPath(pattern, hops)
+	}
+	return nil
+}
+
+// checkPath validates a path variable before its pattern is joined.
+// shortestPath takes a single variable-length relation, as in Cypher.
+func (pj *patternJoins) checkPath(pattern *ast.PathPattern) error {
+	if pj.isBound(pattern.Variable) {
+		return fmt.Errorf("variable %s is already bound", pattern.Variable)
+	}
+	if pattern.Shortest && (len(pattern.Edges) != 1 || pattern.Edges[0].MaxHops == 0) {
+		return fmt.Errorf("shortestPath requires a single variable-length relation, e.g. shortestPath((a)-[:calls*]->(b))")
+	}
+	return nil
+}
+
+// bindPath records the node list and length of a joined path pattern.
+// Fixed hops add the entity they reach; variable-length hops add the nodes
+// their CTE recorded after the left node. For shortestPath only the path
+// with the fewest hops between its two ends is kept.
+func (pj *patternJoins) bindPath(pattern *ast.PathPattern, hops []hop) {
+	ids := []string{hops[0].left + ".id"}
+	var lengths []string
+	fixed := 0
+	for _, h := range hops {
+		if h.varLength {
+			ids = append(ids, h.edge+".path")
+			lengths = append(lengths, h.edge+".depth")
+		} else {
+			ids = append(ids, h.right+".id")
+			fixed++
+		}
+	}
+	if fixed > 0 || len(lengths) == 0 {
+		lengths = append([]string{strconv.Itoa(fixed)}, lengths...)
+	}
+	length := strings.Join(lengths, " + ")
+	if len(lengths) > 1 {
+		length = "(" + length + ")"
+	}
+	pj.paths[pattern.Variable] = pathVar{
+		nodes:  "'[' || " + strings.Join(ids, " || ',' || ") + " || ']'",
+		length: length,
+	}
+
+	if pattern.Shortest {
+		p := hops[0].edge
+		cte := "paths" + strings.TrimPrefix(p, "p")
+		pj.conds = append(pj.conds, fmt.Sprintf(
+			"%[1]s.path = (SELECT sp.path FROM %[2]s sp WHERE sp.source_id = %[1]s.source_id AND sp.target_id = %[1]s.target_id AND sp.depth >= ? ORDER BY sp.depth LIMIT 1)",
+			p, cte))
+		pj.condArgs = append(pj.condArgs, pattern.Edges[0].MinHops)
+	}
+}
+
+// addHop joins one edge between two pattern nodes
+func (pj *patternJoins) addHop(left *ast.Node, edge *ast.Edge, right *ast.Node) (hop, error) {
+	// Direction decides which visual node is the relation source
+	var srcNode, tgtNode *ast.Node
+	switch edge.Direction {
+	case "->":
+		srcNode, tgtNode = left, right
+	case "<-":
+		srcNode, tgtNode = right, left
+	default:
+		// Undirected: undirected_edges holds every edge in both directions
+		srcNode, tgtNode = left, right
+		pj.undirected = true
+	}
+
+	pj.hops++
+	suffix := ""
+	if pj.hops > 1 {
+		suffix = strconv.Itoa(pj.hops)
+	}
+	variableLength := edge.MinHops > 0 || edge.MaxHops > 0
+
+	edgeTable := "graph_edges"
+	if edge.Direction == "-" {
+		edgeTable = "undirected_edges"
+	}
+
+	edgeAlias, table := "g"+suffix, edgeTable
+	srcCol, tgtCol := "source_entity_id", "target_entity_id"
+	if variableLength {
+		edgeAlias, table = "p"+suffix, "paths"+suffix
+		srcCol, tgtCol = "source_id", "target_id"
+		pj.distinct = true
+	}
+
+	switch {
+	case edge.Variable == "":
+	case pj.isBound(edge.Variable):
+		return hop{}, fmt.Errorf("variable %s is already bound", edge.Variable)
+	case variableLength:
+		// Only the hop count of a variable-length relation is exposed
+		pj.depths[edge.Variable] = edgeAlias
+	default:
+		pj.vars[edge.Variable] = edgeAlias
+		pj.rels = append(pj.rels, edgeAlias)
+	}
+
+	srcAlias, srcBound := pj.lookup(srcNode)
+	tgtAlias, tgtBound := pj.lookup(tgtNode)
+	srcNew := false
+
+	// Seeded before this hop joins anything, so bound ids come from the
+	// query so far
+	var start seed
+	if variableLength {
+		var err error
+		if start, err = pj.walkSeed(srcNode, srcAlias, tgtNode, tgtAlias); err != nil {
+			return hop{}, err
+		}
+	}
+
+	// Anchor a hop that touches no bound node at its source entity
+	if !srcBound && !tgtBound {
+		srcAlias, _ = pj.bind(srcNode, "")
+		srcBound, srcNew = true, true
+	}
+
+	edgeJoin := sqlJoin{op: "JOIN", table: table + " " + edgeAlias}
+	srcCond := joinCond{fmt.Sprintf("%s.%s = %s.id", edgeAlias, srcCol, srcAlias), srcAlias}
+	tgtCond := joinCond{fmt.Sprintf("%s.%s = %s.id", edgeAlias, tgtCol, tgtAlias), tgtAlias}
+
+	switch {
+	case srcBound && tgtBound:
+		edgeJoin.on = []joinCond{srcCond, tgtCond}
+		pj.joins = append(pj.joins, edgeJoin)
+	case srcBound:
+		edgeJoin.on = []joinCond{srcCond}
+		pj.joins = append(pj.joins, edgeJoin)
+		tgtAlias, _ = pj.bind(tgtNode, edgeAlias+"."+tgtCol)
+	default:
+		edgeJoin.on = []joinCond{tgtCond}
+		pj.joins = append(pj.joins, edgeJoin)
+		srcAlias, _ = pj.bind(srcNode, edgeAlias+"."+srcCol)
+		srcNew = true
+	}
+
+	if pj.opts.CWD != "" {
+		cond, args, err := cwdCondition(pj.opts, fileColumn(srcAlias), fileColumn(tgtAlias))
+		if err != nil {
+			return hop{}, err
+		}
+		pj.scope = append(pj.scope, cond)
+		pj.scopeArg = append(pj.scopeArg, args...)
+	}
+
+	if variableLength {
+		// The label of a new source seeding the walk holds for every path
+		if srcNew && !start.reverse {
+			pj.labeled[srcAlias+":"+srcNode.Label] = true
+		}
+		if err := pj.addNode(srcAlias, srcNode); err != nil {
+			return hop{}, err
+		}
+		// Paths are joined as walked, one row per path whether or not a
+		// path variable is bound
+		rowCap := pj.opts.rowCap()
+		pj.ctes = append(pj.ctes, recursiveCTE(table, edgeTable, edge, start, pj.tracking, rowCap))
+		pj.conds = append(pj.conds, edgeAlias+".depth >= ?", rowCapCondition(table))
+		pj.condArgs = append(pj.condArgs, edge.MinHops, rowCap)
+	} else {
+		if err := pj.addNode(srcAlias, srcNode); err != nil {
+			return hop{}, err
+		}
+		if len(edge.Types) > 0 {
+			cond, args := relationTypeCondition(edgeAlias, edge.Types)
+			pj.conds = append(pj.conds, cond)
+			pj.condArgs = append(pj.condArgs, args...)
+		}
+	}
+
+	if err := pj.addNode(tgtAlias, tgtNode); err != nil {
+		return hop{}, err
+	}
+	h := hop{left: srcAlias, right: tgtAlias, edge: edgeAlias, varLength: variableLength}
+	if edge.Direction == "<-" {
+		h.left, h.right = tgtAlias, srcAlias
+	}
+	return h, nil
+}
+
+// isBound reports whether a variable names anything in scope
+func (pj *patternJoins) isBound(v string) bool {
+	_, isVar := pj.vars[v]
+	_, isScalar := pj.scalars[v]
+	_, isPath := pj.paths[v]
+	_, isDepth := pj.depths[v]
+	return isVar || isScalar || isPath || isDepth
+}
+
+// lookup returns the alias of a node that is already bound, by variable
+// or, for an anonymous node, by its position in the pattern
+func (pj *patternJoins) lookup(node *ast.Node) (string, bool) {
+	if node.Variable == "" {
+		alias, ok := pj.anon[node]
+		return alias, ok
+	}
+	alias, ok := pj.vars[node.Variable]
+	return alias, ok
+}
+
+// bind allocates the next entity alias for a node unless its variable is
+// already bound. on is the column the new entity is joined against; without
+// one the entity starts the FROM clause or is cross joined.
+func (pj *patternJoins) bind(node *ast.Node, on string) (string, bool) {
+	if alias, ok := pj.lookup(node); ok {
+		return alias, false
+	}
+	pj.bound++
+	alias := fmt.Sprintf("e%d", pj.bound)
+	pj.entities = append(pj.entities, alias)
+	if node.Variable != "" {
+		pj.vars[node.Variable] = alias
+	} else {
+		pj.anon[node] = alias
+	}
+	switch {
+	case on != "":
+		pj.joins = append(pj.joins, sqlJoin{
+			op:    "JOIN",
+			table: "entities " + alias,
+			on:    []joinCond{{fmt.Sprintf("%s = %s.id", on, alias), alias}},
+		})
+	case len(pj.joins) == 0:
+		pj.joins = append(pj.joins, sqlJoin{op: "FROM", table: "entities " + alias})
+	default:
+		// Disconnected pattern: cartesian product with what came before
+		pj.joins = append(pj.joins, sqlJoin{op: "CROSS JOIN", table: "entities " + alias})
+	}
+	return alias, true
+}
+
+// addLabel filters an entity alias by entity_type, once per alias and label
+func (pj *patternJoins) addLabel(alias, label string) {
+	if label == "" || pj.labeled[alias+":"+label] {
+		return
+	}
+	pj.labeled[alias+":"+label] = true
+	pj.conds = append(pj.conds, alias+".entity_type = ?")
+	pj.condArgs = append(pj.condArgs, label)
+}
+
+// addNode adds the label and inline property filters of a pattern node.
+// Each map entry becomes an equality on the property's column, so virtual
+// properties such as file match against the joined files table.
+func (pj *patternJoins) addNode(alias string, node *ast.Node) error {
+	pj.seeds[alias] = append(pj.seeds[alias], constantFilters(node)...)
+	pj.addLabel(alias, node.Label)
+	for _, prop := range node.Properties {
+		value, args, err := pj.compileExpression(prop.Value)
+		if err != nil {
+			return err
+		}
+		pj.conds = append(pj.conds, fmt.Sprintf("%s = %s", propertyColumn(alias, prop.Key), value))
+		pj.condArgs = append(pj.condArgs, args...)
+	}
+	return nil
+}
+
+// compileExists compiles a pattern predicate into a correlated EXISTS
+// subquery. Variables of the enclosing query are referenced, not joined
+// again; new entities and hops continue the enclosing alias numbering, and
+// recursive CTEs join those of the enclosing statement.
+func (pj *patternJoins) compileExists(e *ast.ExistsExpr) (string, []interface{}, error) {
+	sub := newPatternJoins(pj.opts)
+	for v, alias := range pj.vars {
+		sub.vars[v] = alias
+	}
+	for v, column := range pj.scalars {
+		sub.scalars[v] = column
+	}
+	for v, path := range pj.paths {
+		sub.paths[v] = path
+	}
+	for k := range pj.labeled {
+		sub.labeled[k] = true
+	}
+	for alias, filters := range pj.seeds {
+		sub.seeds[alias] = filters
+	}
+	for v, filters := range pj.whereSeeds {
+		sub.whereSeeds[v] = filters
+	}
+	sub.addWhereSeeds(e.Where)
+	sub.embeddings = pj.embeddings
+	sub.bound, sub.hops = pj.bound, pj.hops
+
+	for _, pattern := range e.Patterns {
+		if err := sub.addPattern(pattern); err != nil {
+			return "", nil, err
+		}
+	}
+	if len(sub.joins) == 0 {
+		return "", nil, fmt.Errorf("pattern predicate must match a relation or a new node")
+	}
+
+	var sql strings.Builder
+	var args []interface{}
+	sql.WriteString("EXISTS (\n")
+	sql.WriteString("    SELECT 1\n")
+
+	// The first hop starts the FROM clause; its join conditions, which tie
+	// it to the enclosing query, move to the WHERE
+	var where []string
+	first := sub.joins[0]
+	sql.WriteString("    FROM " + first.table + "\n")
+	for _, c := range first.on {
+		where = append(where, c.sql)
+	}
+	for _, j := range sub.joins[1:] {
+		sql.WriteString("    " + j.String() + "\n")
+	}
+	for _, line := range chunkJoins(append(append([]string{}, sub.entities...), sub.rels...)) {
+		sql.WriteString("    " + line + "\n")
+	}
+
+	where = append(where, sub.scope...)
+	where = append(where, sub.conds...)
+	args = append(args, sub.scopeArg...)
+	args = append(args, sub.condArgs...)
+	if e.Where != nil {
+		cond, condArgs, err := sub.compileExpression(e.Where.Expr)
+		if err != nil {
+			return "", nil, err
+		}
+		where = append(where, cond)
+		args = append(args, condArgs...)
+	}
+	if len(where) > 0 {
+		sql.WriteString("    WHERE " + strings.Join(where, "\n      AND ") + "\n")
+	}
+	sql.WriteString("  )")
+
+	// CTEs, including those of the WHERE, are hoisted into the enclosing
+	// statement
+	pj.ctes = append(pj.ctes, sub.ctes...)
+	pj.undirected = pj.undirected || sub.undirected
+
+	// Keep aliases unique across the whole statement
+	pj.bound, pj.hops = sub.bound, sub.hops
+	return sql.String(), args, nil
+}
+
+// fromClause renders the joins followed by the chunk and file joins of
+// every entity and named edge outside an OPTIONAL MATCH group. Those an
+// OPTIONAL MATCH group may refer to are joined in front of it.
+func (pj *patternJoins) fromClause() []string {
+	var lines []string
+	joined := map[string]bool{}
+	join := func(aliases []string) {
+		for _, alias := range aliases {
+			if !joined[alias] && !pj.optional[alias] {
+				joined[alias] = true
+				lines = append(lines, chunkJoins([]string{alias})...)
+			}
+		}
+	}
+	for _, j := range pj.joins {
+		join(j.outer)
+		lines = append(lines, j.String())
+	}
+	join(append(append([]string{}, pj.entities...), pj.rels...))
+	return lines
+}
+
+// chunkJoins returns the chunk and file joins that back the virtual
+// snippet/file/lines properties of the given entities or edges
+func chunkJoins(aliases []string) []string {
+	var joins []string
+	for _, alias := range aliases {
+		n := chunkSuffix(alias)
+		joins = append(joins,
+			fmt.Sprintf("LEFT JOIN vec_chunks c%s ON %s.chunk_id = c%s.chunk_id", n, alias, n),
+			fmt.Sprintf("LEFT JOIN files f%s ON c%s.file_id = f%s.id", n, n, n))
+	}
+	return joins
+}
+
+// chunkSuffix names the chunk and file joins of an alias: e2 -> c2/f2,
+// g2 -> cg2/fg2
+func chunkSuffix(alias string) string {
+	if isRelationshipAlias(alias) {
+		return alias
+	}
+	return alias[1:]
+}
+
+// isRelationshipAlias reports whether an alias names a graph_edges join
+func isRelationshipAlias(alias string) bool {
+	return strings.HasPrefix(alias, "g")
+}
+
+// fileColumn returns the file path column joined for an entity alias
+func fileColumn(alias string) string {
+	return "f" + alias[1:] + ".path"
+}
+
+// relationTypeCondition filters an edge alias by one or more relation types
+func relationTypeCondition(alias string, types []string) (string, []interface{}) {
+	args := make([]interface{}, len(types))
+	for i, t := range types {
+		args[i] = t
+	}
+	if len(types) == 1 {
+		return alias + ".relation_type = ?", args
+	}
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(types)), ", ")
+	return fmt.Sprintf("%s.relation_type IN (%s)", alias, placeholders), args
+}
+
+// rowCapCondition fails the query through the row_cap() SQL function,
+// registered by db.Open, when a recursive CTE reached its row cap
+func rowCapCondition(cte string) string {
+	return fmt.Sprintf("(SELECT row_cap(COUNT(*), ?) FROM %s)", cte)
+}
+
+// undirectedEdgesCTE exposes every edge in both directions with the columns
+// of graph_edges, so undirected hops join it like a directed one. edge_id
+// is the rowid of the edge, the same in both directions.
+const undirectedEdgesCTE = `undirected_edges(source_entity_id, target_entity_id, relation_type, chunk_id, weight, edge_id) AS (
+  SELECT source_entity_id, target_entity_id, relation_type, chunk_id, weight, rowid FROM graph_edges
+  UNION ALL
+  SELECT target_entity_id, source_entity_id, relation_type, chunk_id, weight, rowid FROM graph_edges
+)`
+
+// seed is where a recursive CTE starts walking, so it does not walk the
+// whole graph: the conditions on the entity at the anchored end of its
+// first edge, e1 for the source and e2 for the target, and the chunk and
+// file joins they read. A walk anchored at the target runs backward.
+type seed struct {
+	reverse bool
+	joins   []string
+	conds   []string
+	args    []interface{}
+}
+
+// walkSeed anchors a variable-length hop at its bound end, if any, or else
+// at the end with constant filters. A bound anchor is seeded with the ids
+// the query so far binds it to; inside OPTIONAL MATCH groups and pattern
+// predicates, which cannot be queried on their own, only its constant
+// filters are used.
+func (pj *patternJoins) walkSeed(src *ast.Node, srcAlias string, tgt *ast.Node, tgtAlias string) (seed, error) {
+	var s seed
+	var anchor *ast.Node
+	alias := ""
+	switch {
+	case srcAlias != "":
+		anchor, alias = src, srcAlias
+	case tgtAlias != "":
+		anchor, alias, s.reverse = tgt, tgtAlias, true
+	case len(pj.filters("", src)) > 0:
+		anchor = src
+	case len(pj.filters("", tgt)) > 0:
+		anchor, s.reverse = tgt, true
+	default:
+		return s, nil
+	}
+	end := "e1"
+	if s.reverse {
+		end = "e2"
+	}
+
+	if alias != "" && !pj.grouping && len(pj.joins) > 0 && pj.joins[0].op == "FROM" {
+		var sub strings.Builder
+		sub.WriteString("SELECT " + alias + ".id")
+		for _, line := range pj.fromClause() {
+			sub.WriteString("\n" + line)
+		}
+		where := append(append([]string{}, pj.scope...), pj.conds...)
+		if len(where) > 0 {
+			sub.WriteString("\nWHERE " + strings.Join(where, "\n  AND "))
+		}
+		indented := strings.ReplaceAll(sub.String(), "\n", "\n      ")
+		s.conds = append(s.conds, fmt.Sprintf("%s.id IN (\n      %s\n    )", end, indented))
+		s.args = append(s.args, pj.joinArgs...)
+		s.args = append(s.args, pj.scopeArg...)
+		s.args = append(s.args, pj.condArgs...)
+	}
+
+	joined := false
+	for _, f := range pj.filters(alias, anchor) {
+		value, args, err := pj.compileExpression(f.Value)
+		if err != nil {
+			return seed{}, err
+		}
+		switch f.Key {
+		case "snippet", "file", "lines":
+			if !joined {
+				s.joins = chunkJoins([]string{end})
+				joined = true
+			}
+		}
+		s.conds = append(s.conds, fmt.Sprintf("%s = %s", propertyColumn(end, f.Key), value))
+		s.args = append(s.args, args...)
+	}
+	return s, nil
+}
+
+// filters returns the constant filters known for a pattern node: those of
+// the earlier nodes bound to alias, its own label and properties, and the
+// equalities the WHERE requires of its variable, without repeats
+func (pj *patternJoins) filters(alias string, node *ast.Node) []*ast.PropertyEntry {
+	var all []*ast.PropertyEntry
+	if alias != "" {
+		all = append(all, pj.seeds[alias]...)
+	}
+	all = append(all, constantFilters(node)...)
+	if node.Variable != "" {
+		all = append(all, pj.whereSeeds[node.Variable]...)
+	}
+
+	seen := map[string]bool{}
+	var filters []*ast.PropertyEntry
+	for _, f := range all {
+		key := fmt.Sprintf("%s=%#v", f.Key, f.Value)
+		if !seen[key] {
+			seen[key] = true
+			filters = append(filters, f)
+		}
+	}
+	return filters
+}
+
+// constantFilters returns the label of a pattern node, as an entity_type
+// property, and its inline properties whose values are constants
+func constantFilters(node *ast.Node) []*ast.PropertyEntry {
+	var filters []*ast.PropertyEntry
+	if node.Label != "" {
+		filters = append(filters, &ast.PropertyEntry{Key: "entity_type", Value: &ast.Literal{Value: node.Label}})
+	}
+	for _, prop := range node.Properties {
+		if isConstant(prop.Value) {
+			filters = append(filters, prop)
+		}
+	}
+	return filters
+}
+
+// addWhereSeeds records the equalities of a WHERE clause between a property
+// and a constant that hold for every row, i.e. those joined by AND at its
+// top level
+func (pj *patternJoins) addWhereSeeds(where *ast.WhereClause) {
+	if where == nil {
+		return
+	}
+	var walk func(expr ast.Expression)
+	walk = func(expr ast.Expression) {
+		switch e := expr.(type) {
+		case *ast.BinaryExpr:
+			if e.Op == "AND" {
+				walk(e.Left)
+				walk(e.Right)
+			}
+		case *ast.Comparison:
+			if e.Op != "=" {
+				return
+			}
+			ref, ok := e.Left.(*ast.PropertyRef)
+			value := e.Right
+			if !ok {
+				ref, ok = e.Right.(*ast.PropertyRef)
+				value = e.Left
+			}
+			if ok && isConstant(value) {
+				pj.whereSeeds[ref.Variable] = append(pj.whereSeeds[ref.Variable], &ast.PropertyEntry{Key: ref.Property, Value: value})
+			}
+		}
+	}
+	walk(where.Expr)
+}
+
+// isConstant reports whether an expression is a non-null literal or a
+// parameter
+func isConstant(expr ast.Expression) bool {
+	switch e := expr.(type) {
+	case *ast.Literal:
+		return e.Value != nil
+	case *ast.Parameter:
+		return true
+	}
+	return false
+}
+
+// recursiveCTE builds the transitive closure of a variable-length edge over
+// edgeTable (graph_edges or undirected_edges), starting from the edges that
+// match its seed. A forward walk extends paths at their target, a backward
+// one at their source; either way source_id and target_id are the ends of
+// the relation.
+//
+// Each row is one path, with or without track. As in Cypher a path may pass
+// a node more than once but never uses the same relationship twice: an edges
+// column lists the edges walked, so cycles end once their edges are used up.
+// With track set, a path column also lists the ids of the nodes after the
+// left node of the pattern, comma separated in pattern order. The CTE stops
+// after rowCap+1 rows, which rowCapCondition turns into an error.
+func recursiveCTE(name, edgeTable string, edge *ast.Edge, start seed, track bool, rowCap int) cte {
+	var sql strings.Builder
+	var args []interface{}
+
+	// Each step adds the node at the open end of the path
+	step := "p.source_id, g.target_entity_id, p.depth + 1"
+	join := "p.target_id = g.source_entity_id"
+	if start.reverse {
+		step = "g.source_entity_id, p.target_id, p.depth + 1"
+		join = "g.target_entity_id = p.source_id"
+	}
+	edgeID := "g.rowid"
+	if edgeTable == "undirected_edges" {
+		edgeID = "g.edge_id"
+	}
+	baseEdges := fmt.Sprintf(", CAST(%s AS TEXT)", edgeID)
+	stepEdges := fmt.Sprintf(", p.edges || ',' || %s", edgeID)
+
+	basePath, stepPath := "", ""
+	if track {
+		// The left node of the pattern is the source of forward edges and
+		// the target of backward ones
+		left := edge.Direction != "<-"
+		switch {
+		case left && !start.reverse:
+			basePath, stepPath = ", CAST(g.target_entity_id AS TEXT)", ", p.path || ',' || g.target_entity_id"
+		case left:
+			basePath, stepPath = ", CAST(g.target_entity_id AS TEXT)", ", g.target_entity_id || ',' || p.path"
+		case !start.reverse:
+			basePath, stepPath = ", CAST(g.source_entity_id AS TEXT)", ", g.source_entity_id || ',' || p.path"
+		default:
+			basePath, stepPath = ", CAST(g.source_entity_id AS TEXT)", ", p.path || ',' || g.source_entity_id"
+		}
+		fmt.Fprintf(&sql, "%s(source_id, target_id, depth, edges, path) AS (\n", name)
+	} else {
+		fmt.Fprintf(&sql, "%s(source_id, target_id, depth, edges) AS (\n", name)
+	}
+
+	// Base case: direct edges (depth 1)
+	fmt.Fprintf(&sql, "  SELECT g.source_entity_id, g.target_entity_id, 1%s%s\n", baseEdges, basePath)
+	fmt.Fprintf(&sql, "  FROM %s g\n", edgeTable)
+	sql.WriteString("  JOIN entities e1 ON g.source_entity_id = e1.id\n")
+	sql.WriteString("  JOIN entities e2 ON g.target_entity_id = e2.id\n")
+	for _, line := range start.joins {
+		sql.WriteString("  " + line + "\n")
+	}
+	sql.WriteString("  WHERE 1=1\n")
+
+	for _, cond := range start.conds {
+		fmt.Fprintf(&sql, "    AND %s\n", cond)
+	}
+	args = append(args, start.args...)
+	typeCond, typeArgs := relationTypeCondition("g", edge.Types)
+	if len(edge.Types) > 0 {
+		fmt.Fprintf(&sql, "    AND %s\n", typeCond)
+		args = append(args, typeArgs...)
+	}
+
+	sql.WriteString("\n  UNION ALL\n\n")
+
+	// Recursive case: extend paths. CROSS JOIN keeps the new rows as the
+	// outer loop, so SQLite looks their edges up by endpoint instead of
+	// scanning every edge of the relation type for each row.
+	fmt.Fprintf(&sql, "  SELECT %s%s%s\n", step, stepEdges, stepPath)
+	fmt.Fprintf(&sql, "  FROM %s p\n", name)
+	fmt.Fprintf(&sql, "  CROSS JOIN %s g ON %s\n", edgeTable, join)
+
+	if len(edge.Types) > 0 {
+		fmt.Fprintf(&sql, "  WHERE %s\n", typeCond)
+		args = append(args, typeArgs...)
+		sql.WriteString("    AND p.depth < ?\n")
+	} else {
+		sql.WriteString("  WHERE p.depth < ?\n")
+	}
+
+	// Add max depth constraint
+	maxDepth := edge.MaxHops
+	if maxDepth == 0 {
+		maxDepth = 10 // Default
+	}
+	args = append(args, maxDepth)
+
+	// No edge is walked twice
+	fmt.Fprintf(&sql, "    AND instr(',' || p.edges || ',', ',' || %s || ',') = 0\n", edgeID)
+
+	sql.WriteString("  LIMIT ?\n")
+	args = append(args, rowCap+1)
+
+	sql.WriteString(")")
+	return cte{name: name, sql: sql.String(), args: args, recursive: true}
+}
@@ -0,0 +1,166 @@
+package cypher
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/wouteroostervld/chainsaw/pkg/cypher/ast"
+)
+
+// procedures lists the columns each CALL-able procedure yields, in order
+var procedures = map[string][]string{
+	"chainsaw.search":      {"node", "distance"},
+	"db.labels":            {"label", "count"},
+	"db.relationshipTypes": {"relationshipType", "count"},
+	"db.schema":            {"source", "relationship", "target", "count", "example"},
+}
+
+// addCall joins the rows of a CALL onto the query and binds the columns it
+// yields. The db.* procedures describe the graph in the current scope: the
+// entity types, the relation types and which types each relation connects,
+// with their counts.
+func (pj *patternJoins) addCall(call *ast.CallClause) error {
+	columns, ok := procedures[call.Procedure]
+	if !ok {
+		return fmt.Errorf("unknown procedure %s; available: %s", call.Procedure, strings.Join(Procedures(), ", "))
+	}
+	for _, item := range call.Yield {
+		if !contains(columns, item.Name) {
+			return fmt.Errorf("%s yields %s, not %s", call.Procedure, strings.Join(columns, ", "), item.Name)
+		}
+	}
+	if call.Procedure == "chainsaw.search" {
+		return pj.addSearch(call)
+	}
+	if len(call.Args) > 0 {
+		return fmt.Errorf("%s() takes no arguments", call.Procedure)
+	}
+
+	c, err := introspectionCTE(call.Procedure, pj.opts)
+	if err != nil {
+		return err
+	}
+	pj.ctes = append(pj.ctes, c)
+	pj.joins = append(pj.joins, sqlJoin{op: "FROM", table: c.name})
+	for _, item := range call.Yield {
+		name := item.Name
+		if item.Alias != "" {
+			name = item.Alias
+		}
+		if pj.isBound(name) {
+			return fmt.Errorf("variable %s is yielded twice", name)
+		}
+		pj.scalars[name] = c.name + "." + item.Name
+	}
+	return nil
+}
+
+// standaloneCall completes a query consisting of a single CALL: without
+// YIELD it yields every column, and it returns what it yields, most common
+// first
+func standaloneCall(q *ast.Query) (*ast.Query, error) {
+	columns, ok := procedures[q.Call.Procedure]
+	if !ok {
+		return nil, fmt.Errorf("unknown procedure %s; available: %s", q.Call.Procedure, strings.Join(Procedures(), ", "))
+	}
+	call := *q.Call
+	if len(call.Yield) == 0 {
+		for _, column := range columns {
+			call.Yield = append(call.Yield, ast.YieldItem{Name: column})
+		}
+	}
+
+	standalone := *q
+	standalone.Call = &call
+	standalone.Return = &ast.ReturnClause{}
+	sortBy := ""
+	for _, item := range call.Yield {
+		name := item.Name
+		if item.Alias != "" {
+			name = item.Alias
+		}
+		standalone.Return.Items = append(standalone.Return.Items, ast.ReturnItem{Variable: name})
+		if item.Name == "count" {
+			sortBy = name
+		}
+	}
+	if sortBy != "" {
+		standalone.OrderBy = &ast.OrderByClause{Items: []ast.OrderByItem{{Expression: sortBy}}}
+	}
+	return &standalone, nil
+}
+
+// introspectionCTE builds the CTE computing a db.* procedure. Entities count
+// when their file is under CWD; relations follow the query's scope.
+func introspectionCTE(procedure string, opts TranspileOptions) (cte, error) {
+	var sql strings.Builder
+	var args []interface{}
+	switch procedure {
+	case "db.labels":
+		sql.WriteString("labels(label, count) AS (\n")
+		sql.WriteString("  SELECT e.entity_type, COUNT(*)\n")
+		sql.WriteString("  FROM entities e\n")
+		if opts.CWD != "" {
+			sql.WriteString("  JOIN vec_chunks c ON e.chunk_id = c.chunk_id\n")
+			sql.WriteString("  JOIN files f ON c.file_id = f.id\n")
+			sql.WriteString("  WHERE f.path LIKE ?\n")
+			args = append(args, cwdPattern(opts.CWD))
+		}
+		sql.WriteString("  GROUP BY e.entity_type\n)")
+		return cte{name: "labels", sql: sql.String(), args: args}, nil
+
+	case "db.relationshipTypes":
+		sql.WriteString("relationship_types(relationshipType, count) AS (\n")
+		sql.WriteString("  SELECT g.relation_type, COUNT(*)\n")
+	case "db.schema":
+		sql.WriteString("schema(source, relationship, target, count, example) AS (\n")
+		sql.WriteString("  SELECT es.entity_type, g.relation_type, et.entity_type, COUNT(*),\n")
+		sql.WriteString("    MIN(es.name || ' -[' || g.relation_type || ']-> ' || et.name)\n")
+	default:
+		return cte{}, fmt.Errorf("unknown procedure %s", procedure)
+	}
+
+	sql.WriteString("  FROM graph_edges g\n")
+	if procedure == "db.schema" || opts.CWD != "" {
+		sql.WriteString("  JOIN entities es ON g.source_entity_id = es.id\n")
+		sql.WriteString("  JOIN entities et ON g.target_entity_id = et.id\n")
+	}
+	if opts.CWD != "" {
+		sql.WriteString("  LEFT JOIN vec_chunks cs ON es.chunk_id = cs.chunk_id\n")
+		sql.WriteString("  LEFT JOIN files fs ON cs.file_id = fs.id\n")
+		sql.WriteString("  LEFT JOIN vec_chunks ct ON et.chunk_id = ct.chunk_id\n")
+		sql.WriteString("  LEFT JOIN files ft ON ct.file_id = ft.id\n")
+		cond, condArgs, err := cwdCondition(opts, "fs.path", "ft.path")
+		if err != nil {
+			return cte{}, err
+		}
+		sql.WriteString("  WHERE " + cond + "\n")
+		args = append(args, condArgs...)
+	}
+	if procedure == "db.schema" {
+		sql.WriteString("  GROUP BY es.entity_type, g.relation_type, et.entity_type\n)")
+		return cte{name: "schema", sql: sql.String(), args: args}, nil
+	}
+	sql.WriteString("  GROUP BY g.relation_type\n)")
+	return cte{name: "relationship_types", sql: sql.String(), args: args}, nil
+}
+
+// Procedures lists the procedures CALL accepts, in alphabetical order
+func Procedures() []string {
+	var names []string
+	for name := range procedures {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,323 @@
+package cypher
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/wouteroostervld/chainsaw/pkg/db"
+)
+
+// openGraph creates a database holding a small graph spread over two
+// roots, /proj and /other:
+//
+//	Handler -calls-> NewServer -creates-> Server -implements-> Iface
+//	Remote -implements-> Iface
+//	A -calls-> B -calls-> C -calls-> A
+//
+// Handler, NewServer, Server, Store and the STEP cycle A, B, C live under
+// /proj, Iface and Remote under /other.
+func openGraph(t *testing.T) *db.DB {
+	t.Helper()
+	d, err := db.Open(db.Config{Path: filepath.Join(t.TempDir(), "test.db"), EmbeddingDim: 4})
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	t.Cleanup(func() { d.Close() })
+
+	chunks := map[string]int64{}
+	for i, path := range []string{"/proj/server.go", "/proj/store.go", "/other/api.go"} {
+		fileID, err := d.UpsertFile(path, 1, "hash")
+		if err != nil {
+			t.Fatalf("Failed to insert file: %v", err)
+		}
+		embedding := make([]float32, 4)
+		embedding[i] = 1
+		chunks[path], err = d.InsertChunk(fileID, "snippet of "+path, embedding, 1, 10)
+		if err != nil {
+			t.Fatalf("Failed to insert chunk: %v", err)
+		}
+	}
+
+	ids := map[string]int64{}
+	for _, e := range []struct{ name, label, path string }{
+		{"Handler", "FUNCTION", "/proj/server.go"},
+		{"NewServer", "FUNCTION", "/proj/server.go"},
+		{"Server", "STRUCT", "/proj/server.go"},
+		{"Store", "STRUCT", "/proj/store.go"},
+		{"Iface", "INTERFACE", "/other/api.go"},
+		{"Remote", "STRUCT", "/other/api.go"},
+		{"A", "STEP", "/proj/store.go"},
+		{"B", "STEP", "/proj/store.go"},
+		{"C", "STEP", "/proj/store.go"},
+	} {
+		id, err := d.UpsertEntity(e.name, e.label, chunks[e.path])
+		if err != nil {
+			t.Fatalf("Failed to insert entity: %v", err)
+		}
+		ids[e.name] = id
+	}
+	for _, e := range []struct{ source, relation, target string }{
+		{"Handler", "calls", "NewServer"},
+		{"NewServer", "creates", "Server"},
+		{"Server", "implements", "Iface"},
+		{"Remote", "implements", "Iface"},
+		{"A", "calls", "B"},
+		{"B", "calls", "C"},
+		{"C", "calls", "A"},
+	} {
+		if err := d.UpsertEntityEdge(ids[e.source], ids[e.target], e.relation, chunks["/proj/server.go"]); err != nil {
+			t.Fatalf("Failed to insert edge: %v", err)
+		}
+	}
+	return d
+}
+
+// runQuery transpiles and runs a query, returning each row as its values
+// joined by "|", with NULL for missing values
+func runQuery(d *db.DB, query string, opts TranspileOptions) ([]string, error) {
+	result, err := Transpile(query, opts)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := d.RawQuery(result.SQL, result.Args...)
+	if err != nil {
+		return nil, fmt.Errorf("%w\n%s", err, result.SQL)
+	}
+	defer rows.Close()
+
+	cols, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	var got []string
+	for rows.Next() {
+		values := make([]interface{}, len(cols))
+		ptrs := make([]interface{}, len(cols))
+		for i := range values {
+			ptrs[i] = &values[i]
+		}
+		if err := rows.Scan(ptrs...); err != nil {
+			return nil, err
+		}
+		fields := make([]string, len(values))
+		for i, v := range values {
+			switch v := v.(type) {
+			case nil:
+				fields[i] = "NULL"
+			case []byte:
+				fields[i] = string(v)
+			default:
+				fields[i] = fmt.Sprint(v)
+			}
+		}
+		got = append(got, strings.Join(fields, "|"))
+	}
+	return got, rows.Err()
+}
+
+func TestQueryOptionalMatch(t *testing.T) {
+	d := openGraph(t)
+
+	tests := []struct {
+		name  string
+		query string
+		cwd   string
+		want  []string
+	}{
+		{
+			name:  "within a CWD",
+			query: "MATCH (s:STRUCT) OPTIONAL MATCH (s)-[:implements]->(i) RETURN s.name AS struct, i.name AS iface ORDER BY struct",
+			cwd:   "/proj",
+			want:  []string{"Server|Iface", "Store|NULL"},
+		},
+		{
+			name:  "WHERE on the file of a bound node",
+			query: "MATCH (s:STRUCT) OPTIONAL MATCH (s)-[:implements]->(i) WHERE s.file = '/proj/server.go' RETURN s.name AS struct, i.name AS iface ORDER BY struct",
+			want:  []string{"Remote|NULL", "Server|Iface", "Store|NULL"},
+		},
+		{
+			name:  "after WITH DISTINCT within a CWD",
+			query: "MATCH (f:FUNCTION)-[:calls|creates]->(b) WITH DISTINCT b OPTIONAL MATCH (b)-[:implements]->(i) RETURN b.name AS name, i.name AS iface ORDER BY name",
+			cwd:   "/proj",
+			want:  []string{"NewServer|NULL", "Server|Iface"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := runQuery(d, tt.query, TranspileOptions{CWD: tt.cwd})
+			if err != nil {
+				t.Fatalf("Query failed: %v", err)
+			}
+			if strings.Join(got, "\n") != strings.Join(tt.want, "\n") {
+				t.Errorf("Rows = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQueryVariableLengthSeed(t *testing.T) {
+	d := openGraph(t)
+
+	tests := []struct {
+		name    string
+		query   string
+		rowCap  int
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:   "forward from an inline property",
+			query:  "MATCH (a {name: 'Handler'})-[*]->(b) RETURN b.name AS name ORDER BY name",
+			rowCap: 3,
+			want:   []string{"Iface", "NewServer", "Server"},
+		},
+		{
+			name:   "forward from a WHERE equality",
+			query:  "MATCH (a)-[*]->(b) WHERE a.name = 'Handler' RETURN b.name AS name ORDER BY name",
+			rowCap: 3,
+			want:   []string{"Iface", "NewServer", "Server"},
+		},
+		{
+			name:   "backward from the target",
+			query:  "MATCH (a)-[*]->(b:INTERFACE {name: 'Iface'}) RETURN a.name AS name ORDER BY name",
+			rowCap: 4,
+			want:   []string{"Handler", "NewServer", "Remote", "Server"},
+		},
+		{
+			name:   "from a bound variable",
+			query:  "MATCH (s:STRUCT {name: 'Server'}) WITH s MATCH (a)-[*]->(s) RETURN a.name AS name ORDER BY name",
+			rowCap: 2,
+			want:   []string{"Handler", "NewServer"},
+		},
+		{
+			name:    "unseeded walk hits the cap",
+			query:   "MATCH (a)-[*]->(b) RETURN b.name",
+			rowCap:  3,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := runQuery(d, tt.query, TranspileOptions{RowCap: tt.rowCap})
+			if tt.wantErr {
+				if err == nil || !strings.Contains(err.Error(), "row cap") {
+					t.Fatalf("Expected row cap error, got rows %q, error %v", got, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Query failed: %v", err)
+			}
+			if strings.Join(got, "\n") != strings.Join(tt.want, "\n") {
+				t.Errorf("Rows = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQuerySearchScope(t *testing.T) {
+	d := openGraph(t)
+	// nearest to /other/api.go, then to /proj/server.go
+	embed := func(string) ([]float32, error) {
+		return []float32{0.1, 0, 1, 0}, nil
+	}
+
+	tests := []struct {
+		name string
+		cwd  string
+		want []string
+	}{
+		{
+			name: "nearest chunk anywhere",
+			want: []string{"Iface", "Remote"},
+		},
+		{
+			name: "nearest chunk within the CWD",
+			cwd:  "/proj",
+			want: []string{"Handler", "NewServer", "Server"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query := `CALL chainsaw.search("server", 1) YIELD node RETURN node.name AS name ORDER BY name`
+			got, err := runQuery(d, query, TranspileOptions{CWD: tt.cwd, Embed: embed})
+			if err != nil {
+				t.Fatalf("Query failed: %v", err)
+			}
+			if strings.Join(got, "\n") != strings.Join(tt.want, "\n") {
+				t.Errorf("Rows = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQueryVariableLengthCycle(t *testing.T) {
+	d := openGraph(t)
+
+	// A path may come back to a node, but never walks an edge twice
+	tests := []struct {
+		name  string
+		match string
+		ret   string
+		want  []string
+	}{
+		{
+			name:  "range",
+			match: "(a:STEP {name: 'A'})-[:calls*1..5]->(b)",
+			ret:   "b.name AS name ORDER BY name",
+			want:  []string{"A", "B", "C"},
+		},
+		{
+			name:  "back at the start",
+			match: "(a:STEP {name: 'A'})-[:calls*3..3]->(b)",
+			ret:   "b.name AS name",
+			want:  []string{"A"},
+		},
+		{
+			name:  "past the cycle",
+			match: "(a:STEP {name: 'A'})-[:calls*4..4]->(b)",
+			ret:   "b.name AS name",
+			want:  nil,
+		},
+		{
+			name:  "undirected edges are walked once",
+			match: "(a:STEP {name: 'A'})-[:calls*2..2]-(b)",
+			ret:   "b.name AS name ORDER BY name",
+			want:  []string{"B", "C"},
+		},
+		{
+			name:  "one row per path",
+			match: "(a:STEP)-[:calls*1..5]->(b:STEP {name: 'A'})",
+			ret:   "COUNT(*) AS paths, COUNT(DISTINCT a) AS starts",
+			want:  []string{"3|3"},
+		},
+		{
+			name:  "two routes between the same ends",
+			match: "(a:STEP {name: 'A'})-[:calls*1..2]-(b {name: 'C'})",
+			ret:   "COUNT(*) AS paths, COUNT(DISTINCT b) AS ends",
+			want:  []string{"2|1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, query := range []string{
+				"MATCH " + tt.match + " RETURN " + tt.ret,
+				"MATCH p = " + tt.match + " RETURN " + tt.ret,
+			} {
+				got, err := runQuery(d, query, TranspileOptions{})
+				if err != nil {
+					t.Fatalf("Query failed: %v", err)
+				}
+				if strings.Join(got, "\n") != strings.Join(tt.want, "\n") {
+					t.Errorf("%s: rows = %q, want %q", query, got, tt.want)
+				}
+			}
+		})
+	}
+}
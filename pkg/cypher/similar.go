@@ -0,0 +1,182 @@
+package cypher
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	"github.com/wouteroostervld/chainsaw/pkg/cypher/ast"
+)
+
+// DefaultSearchLimit is the number of chunks chainsaw.search returns when
+// called without a limit
+const DefaultSearchLimit = 10
+
+// similarColumn renders similar(variable, text): the cosine distance between
+// the embedding of the chunk a node or relationship was extracted from and
+// the embedding of text, from 0 (same direction) to 2, or NULL without a
+// chunk. Each distinct text is embedded once, into a one-row CTE similar<n>.
+func (pj *patternJoins) similarColumn(variable string, text ast.Expression) (string, error) {
+	if text == nil {
+		return "", fmt.Errorf(`similar() takes a variable and a text, e.g. similar(%s, "retry with backoff")`, variable)
+	}
+	alias, ok := pj.vars[variable]
+	if !ok {
+		return "", fmt.Errorf("unknown variable in similar(): %s", variable)
+	}
+	name, err := pj.embedding(text)
+	if err != nil {
+		return "", err
+	}
+	// NULL, rather than an error, for entities of an unmatched OPTIONAL MATCH
+	chunk := "c" + chunkSuffix(alias) + ".embedding"
+	return fmt.Sprintf("(SELECT vec_distance_cosine(%s, embedding) FROM %s WHERE %s IS NOT NULL)", chunk, name, chunk), nil
+}
+
+// embedding returns the CTE holding the embedding of a similar() text,
+// adding it on first use
+func (pj *patternJoins) embedding(text ast.Expression) (string, error) {
+	value, err := pj.textArgument("similar()", text)
+	if err != nil {
+		return "", err
+	}
+	if name, ok := pj.embeddings[value]; ok {
+		return name, nil
+	}
+	blob, err := pj.embed(value)
+	if err != nil {
+		return "", err
+	}
+	name := fmt.Sprintf("similar%d", len(pj.embeddings)+1)
+	pj.embeddings[value] = name
+	pj.ctes = append(pj.ctes, cte{
+		name: name,
+		sql:  name + "(embedding) AS (SELECT ?)",
+		args: []interface{}{blob},
+	})
+	return name, nil
+}
+
+// addSearch joins CALL chainsaw.search(text, k) onto the query. It runs a
+// KNN query for the k chunks nearest to text and yields every entity defined
+// in them as node, with the chunk's distance. The KNN query itself is scoped
+// to the current directory, so it picks the k nearest chunks under it.
+func (pj *patternJoins) addSearch(call *ast.CallClause) error {
+	if len(call.Args) < 1 || len(call.Args) > 2 {
+		return fmt.Errorf(`chainsaw.search takes a text and an optional limit, e.g. CALL chainsaw.search("retry with backoff", 20)`)
+	}
+	text, err := pj.textArgument("chainsaw.search", call.Args[0])
+	if err != nil {
+		return err
+	}
+	limit := int64(DefaultSearchLimit)
+	if len(call.Args) == 2 {
+		if limit, err = pj.intArgument("chainsaw.search", call.Args[1]); err != nil {
+			return err
+		}
+		if limit <= 0 {
+			return fmt.Errorf("chainsaw.search limit must be positive, got %d", limit)
+		}
+	}
+	blob, err := pj.embed(text)
+	if err != nil {
+		return err
+	}
+	where := "embedding MATCH ? AND k = ?"
+	args := []interface{}{blob, limit}
+	if pj.opts.CWD != "" {
+		where += "\n    AND file_id IN (SELECT id FROM files WHERE path LIKE ?)"
+		args = append(args, cwdPattern(pj.opts.CWD))
+	}
+	pj.ctes = append(pj.ctes, cte{
+		name: "search",
+		sql:  "search(chunk_id, distance) AS (\n  SELECT chunk_id, distance FROM vec_chunks\n  WHERE " + where + "\n)",
+		args: args,
+	})
+
+	pj.bound++
+	alias := fmt.Sprintf("e%d", pj.bound)
+	pj.entities = append(pj.entities, alias)
+	pj.joins = append(pj.joins,
+		sqlJoin{op: "FROM", table: "search k"},
+		sqlJoin{
+			op:    "JOIN",
+			table: "entities " + alias,
+			on:    []joinCond{{fmt.Sprintf("k.chunk_id = %s.chunk_id", alias), alias}},
+		})
+
+	for _, item := range call.Yield {
+		name := item.Name
+		if item.Alias != "" {
+			name = item.Alias
+		}
+		if pj.isBound(name) {
+			return fmt.Errorf("variable %s is yielded twice", name)
+		}
+		if item.Name == "node" {
+			pj.vars[name] = alias
+		} else {
+			pj.scalars[name] = "k.distance"
+		}
+	}
+	return nil
+}
+
+// textArgument resolves a string literal or $parameter passed to fn
+func (pj *patternJoins) textArgument(fn string, arg ast.Expression) (string, error) {
+	value, err := pj.argumentValue(fn, arg)
+	if err != nil {
+		return "", err
+	}
+	text, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("%s takes a text, got %v", fn, value)
+	}
+	return text, nil
+}
+
+// intArgument resolves an integer literal or $parameter passed to fn
+func (pj *patternJoins) intArgument(fn string, arg ast.Expression) (int64, error) {
+	value, err := pj.argumentValue(fn, arg)
+	if err != nil {
+		return 0, err
+	}
+	switch n := value.(type) {
+	case int64:
+		return n, nil
+	case float64:
+		if n == math.Trunc(n) {
+			return int64(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%s takes a whole number, got %v", fn, value)
+}
+
+// argumentValue resolves a literal or $parameter passed to fn
+func (pj *patternJoins) argumentValue(fn string, arg ast.Expression) (interface{}, error) {
+	switch e := arg.(type) {
+	case *ast.Literal:
+		return e.Value, nil
+	case *ast.Parameter:
+		return parameterValue(e, pj.opts.Params)
+	default:
+		return nil, fmt.Errorf("%s takes a literal or $parameter", fn)
+	}
+}
+
+// embed computes the embedding of a text and encodes it as the float32
+// blob sqlite-vec expects
+func (pj *patternJoins) embed(text string) ([]byte, error) {
+	if pj.opts.Embed == nil {
+		return nil, fmt.Errorf("similarity search needs an embedding model, which is not available here")
+	}
+	vector, err := pj.opts.Embed(text)
+	if err != nil {
+		return nil, fmt.Errorf("failed to embed %q: %w", text, err)
+	}
+	blob := make([]byte, 4*len(vector))
+	for i, v := range vector {
+		binary.LittleEndian.PutUint32(blob[4*i:], math.Float32bits(v))
+	}
+	return blob, nil
+}
@@ -0,0 +1,284 @@
+package cypher
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/wouteroostervld/chainsaw/pkg/cypher/errors"
+	"github.com/wouteroostervld/chainsaw/pkg/cypher/token"
+)
+
+// SyntaxError is a query that does not parse. It locates the offending
+// token, lists what the grammar expected there in plain words and, for
+// common mistakes, suggests a fix. It marshals to JSON for tools that
+// correct their own queries.
+type SyntaxError struct {
+	Message    string   `json:"message"`
+	Query      string   `json:"query"`
+	Offset     int      `json:"offset"` // byte offset of the token
+	Line       int      `json:"line"`
+	Column     int      `json:"column"` // in characters, from 1
+	Found      string   `json:"found"`  // offending text, empty at the end of the query
+	Expected   []string `json:"expected"`
+	Suggestion string   `json:"suggestion,omitempty"`
+	length     int      // characters to underline
+}
+
+// Error returns a one-line description of the error
+func (e *SyntaxError) Error() string {
+	return e.Message
+}
+
+// Detail returns the error with the offending line, a caret under the token
+// and the suggested fix, if any
+func (e *SyntaxError) Detail() string {
+	start := strings.LastIndex(e.Query[:e.Offset], "\n") + 1
+	line := e.Query[start:]
+	if end := strings.IndexByte(line, '\n'); end >= 0 {
+		line = line[:end]
+	}
+
+	// Keep tabs so the caret lines up however the terminal expands them
+	var caret strings.Builder
+	for _, r := range e.Query[start:e.Offset] {
+		if r == '\t' {
+			caret.WriteRune('\t')
+		} else {
+			caret.WriteRune(' ')
+		}
+	}
+	caret.WriteString(strings.Repeat("^", e.length))
+
+	var w strings.Builder
+	fmt.Fprintf(&w, "%s\n  %s\n  %s", e.Message, line, caret.String())
+	if e.Suggestion != "" {
+		fmt.Fprintf(&w, "\nhint: %s", e.Suggestion)
+	}
+	return w.String()
+}
+
+// parseError returns a SyntaxError for a query the grammar rejects. Errors
+// raised while building the AST already explain themselves and are kept.
+func parseError(query string, err error) error {
+	parseErr, ok := err.(*errors.Error)
+	if !ok || parseErr.Err != nil || parseErr.ErrorToken == nil {
+		return fmt.Errorf("parse error: %w", err)
+	}
+	return newSyntaxError(query, parseErr)
+}
+
+// newSyntaxError converts the parser's error into a SyntaxError
+func newSyntaxError(query string, err *errors.Error) *SyntaxError {
+	tok := err.ErrorToken
+	offset := tok.Pos.Offset
+	if offset > len(query) {
+		offset = len(query)
+	}
+	lineStart := strings.LastIndex(query[:offset], "\n") + 1
+
+	// An unterminated string runs to the end of the query; underline only
+	// the rest of its first line
+	lit := string(tok.Lit)
+	if end := strings.IndexByte(lit, '\n'); end >= 0 {
+		lit = lit[:end]
+	}
+	length := len([]rune(lit))
+	if length == 0 {
+		length = 1
+	}
+
+	e := &SyntaxError{
+		Query:      query,
+		Offset:     offset,
+		Line:       strings.Count(query[:offset], "\n") + 1,
+		Column:     len([]rune(query[lineStart:offset])) + 1,
+		Found:      lit,
+		Expected:   describeExpected(err.ExpectedTokens),
+		Suggestion: suggestFix(tok, err.ExpectedTokens),
+		length:     length,
+	}
+	e.Message = fmt.Sprintf("syntax error at line %d, column %d: unexpected %s", e.Line, e.Column, describeFound(tok))
+	if len(e.Expected) > 0 {
+		e.Message += ", expected " + joinAlternatives(e.Expected)
+	}
+	return e
+}
+
+// tokenWords names the grammar's token classes in plain words
+var tokenWords = map[string]string{
+	"␚":      "end of query",
+	"id":     "a name",
+	"upid":   "an uppercase name",
+	"int":    "a number",
+	"string": "a quoted string",
+	"param":  "a $parameter",
+}
+
+// describeExpected turns the parser's expected tokens into plain words:
+// keywords as they are written, punctuation quoted and token classes named.
+// Lowercase spellings of TRUE, FALSE and NULL are folded into the uppercase
+// ones.
+func describeExpected(tokens []string) []string {
+	var words []string
+	seen := map[string]bool{}
+	for _, tok := range tokens {
+		word, ok := tokenWords[tok]
+		switch {
+		case ok:
+		case tok == "true" || tok == "false" || tok == "null":
+			word = strings.ToUpper(tok)
+		case isKeyword(tok):
+			word = tok
+		default:
+			word = fmt.Sprintf("%q", tok)
+		}
+		if !seen[word] {
+			seen[word] = true
+			words = append(words, word)
+		}
+	}
+	return words
+}
+
+// describeFound names the offending token
+func describeFound(tok *token.Token) string {
+	switch {
+	case tok.Type == token.EOF:
+		return "end of query"
+	case tok.Type == token.INVALID && strings.HasPrefix(string(tok.Lit), "'"),
+		tok.Type == token.INVALID && strings.HasPrefix(string(tok.Lit), `"`):
+		return "unterminated string"
+	default:
+		return fmt.Sprintf("%q", tok.Lit)
+	}
+}
+
+// joinAlternatives lists alternatives as "a, b or c"
+func joinAlternatives(words []string) string {
+	if len(words) == 1 {
+		return words[0]
+	}
+	return strings.Join(words[:len(words)-1], ", ") + " or " + words[len(words)-1]
+}
+
+// isKeyword reports whether a token of the grammar is a keyword such as
+// MATCH or shortestPath rather than punctuation or a token class
+func isKeyword(tok string) bool {
+	if _, ok := tokenWords[tok]; ok {
+		return false
+	}
+	for _, r := range tok {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z') {
+			return false
+		}
+	}
+	return tok != ""
+}
+
+// unsupportedClauses are Cypher clauses chainsaw does not implement, with
+// whether they write to the graph
+var unsupportedClauses = map[string]bool{
+	"CREATE":  true,
+	"MERGE":   true,
+	"DELETE":  true,
+	"DETACH":  true,
+	"SET":     true,
+	"REMOVE":  true,
+	"UNWIND":  false,
+	"FOREACH": false,
+	"LOAD":    false,
+}
+
+// resultClauses are the clauses after RETURN, in the order they must appear
+var resultClauses = []string{"GROUP", "ORDER", "SKIP", "LIMIT"}
+
+// suggestFix suggests a fix for common mistakes, or returns "" when the
+// error matches none of them
+func suggestFix(tok *token.Token, expected []string) string {
+	lit := string(tok.Lit)
+	expects := map[string]bool{}
+	for _, e := range expected {
+		expects[e] = true
+	}
+
+	if writes, ok := unsupportedClauses[lit]; ok {
+		if writes {
+			return fmt.Sprintf("%s is not supported: graph queries are read-only. Use MATCH, OPTIONAL MATCH, WHERE, WITH, UNION and RETURN", lit)
+		}
+		return fmt.Sprintf("%s is not supported. Use MATCH, OPTIONAL MATCH, WHERE, WITH, UNION and RETURN", lit)
+	}
+
+	switch {
+	case tok.Type == token.INVALID && (strings.HasPrefix(lit, "'") || strings.HasPrefix(lit, `"`)):
+		return fmt.Sprintf("close the string with a matching %s", lit[:1])
+
+	// Keywords are case-sensitive
+	case tok.Type == token.TokMap.Type("id") && expects[strings.ToUpper(lit)] && isKeyword(strings.ToUpper(lit)):
+		return fmt.Sprintf("keywords are uppercase: write %s", strings.ToUpper(lit))
+
+	case expects["upid"] && !expects["id"] && isName(lit):
+		return fmt.Sprintf("labels are uppercase: write :%s", strings.ToUpper(lit))
+	case expects["id"] && !expects["upid"] && isName(lit):
+		return fmt.Sprintf("relation types, variables and properties are lowercase: write %s", strings.ToLower(lit))
+
+	// Relations are written -[...]->, <-[...]- or -[...]-
+	case lit == ">" && expects["-"]:
+		return "a relation ends with ]-> rather than ]>, e.g. (a)-[:calls]->(b)"
+	case len(expected) == 1 && expects["-"]:
+		return "close the relation with -> or -, e.g. (a)-[:calls]->(b)"
+	case lit == "[" && expects["-"]:
+		return "a relation starts with - or <-, e.g. (a)-[:calls]->(b)"
+	case len(expected) == 1 && expects["["]:
+		return "put the relation in brackets, e.g. (a)-[]->(b) or (a)-[:calls]->(b)"
+
+	case lit == "=" && expects["int"]:
+		return "compare with a single ="
+	}
+
+	for i, clause := range resultClauses {
+		if lit != clause {
+			continue
+		}
+		for _, later := range resultClauses[i+1:] {
+			if expects[later] {
+				return ""
+			}
+		}
+		return "result clauses go in the order RETURN, GROUP BY, ORDER BY, SKIP, LIMIT"
+	}
+	return ""
+}
+
+// isName reports whether a token looks like an identifier
+func isName(lit string) bool {
+	if lit == "" {
+		return false
+	}
+	for i, r := range lit {
+		letter := r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r == '_'
+		if !letter && (i == 0 || !(r >= '0' && r <= '9')) {
+			return false
+		}
+	}
+	return true
+}
+
+// Keywords lists the keywords of the grammar, such as MATCH and shortestPath,
+// in alphabetical order. Lowercase spellings of uppercase keywords are left
+// out.
+func Keywords() []string {
+	var keywords []string
+	for typ := token.EOF + 1; token.TokMap.Id(typ) != "unknown"; typ++ {
+		tok := token.TokMap.Id(typ)
+		if !isKeyword(tok) {
+			continue
+		}
+		if upper := strings.ToUpper(tok); upper != tok && token.TokMap.Type(upper) != token.INVALID {
+			continue
+		}
+		keywords = append(keywords, tok)
+	}
+	sort.Strings(keywords)
+	return keywords
+}
@@ -138,56 +138,130 @@ var TokMap = TokenMap{
 	typeMap: []string{
 		"INVALID",
 		"␚",
-		"MATCH",
-		"(",
+		"EXPLAIN",
+		"PROFILE",
+		"UNION",
+		"ALL",
+		"CALL",
 		"id",
+		".",
+		"(",
+		")",
+		"YIELD",
+		",",
+		"AS",
+		"WITH",
+		"DISTINCT",
+		"MATCH",
+		"OPTIONAL",
+		"=",
+		"shortestPath",
 		":",
 		"upid",
-		")",
+		"*",
+		"int",
+		"{",
+		"}",
 		"-",
 		"[",
 		"]",
 		">",
-		"*",
-		"int",
-		".",
 		"<",
+		"|",
+		"WHERE",
+		"OR",
+		"AND",
+		"NOT",
+		"IN",
+		"STARTS",
+		"ENDS",
+		"CONTAINS",
+		"=~",
+		"IS",
+		"NULL",
+		"EXISTS",
+		"<>",
+		"<=",
+		">=",
+		"string",
+		"TRUE",
+		"true",
+		"FALSE",
+		"false",
+		"param",
+		"null",
 		"RETURN",
-		",",
-		"AS",
 		"GROUP",
 		"BY",
 		"ORDER",
 		"ASC",
 		"DESC",
 		"LIMIT",
+		"SKIP",
 	},
 
 	idMap: map[string]Type{
-		"INVALID": 0,
-		"␚":       1,
-		"MATCH":   2,
-		"(":       3,
-		"id":      4,
-		":":       5,
-		"upid":    6,
-		")":       7,
-		"-":       8,
-		"[":       9,
-		"]":       10,
-		">":       11,
-		"*":       12,
-		"int":     13,
-		".":       14,
-		"<":       15,
-		"RETURN":  16,
-		",":       17,
-		"AS":      18,
-		"GROUP":   19,
-		"BY":      20,
-		"ORDER":   21,
-		"ASC":     22,
-		"DESC":    23,
-		"LIMIT":   24,
+		"INVALID":      0,
+		"␚":            1,
+		"EXPLAIN":      2,
+		"PROFILE":      3,
+		"UNION":        4,
+		"ALL":          5,
+		"CALL":         6,
+		"id":           7,
+		".":            8,
+		"(":            9,
+		")":            10,
+		"YIELD":        11,
+		",":            12,
+		"AS":           13,
+		"WITH":         14,
+		"DISTINCT":     15,
+		"MATCH":        16,
+		"OPTIONAL":     17,
+		"=":            18,
+		"shortestPath": 19,
+		":":            20,
+		"upid":         21,
+		"*":            22,
+		"int":          23,
+		"{":            24,
+		"}":            25,
+		"-":            26,
+		"[":            27,
+		"]":            28,
+		">":            29,
+		"<":            30,
+		"|":            31,
+		"WHERE":        32,
+		"OR":           33,
+		"AND":          34,
+		"NOT":          35,
+		"IN":           36,
+		"STARTS":       37,
+		"ENDS":         38,
+		"CONTAINS":     39,
+		"=~":           40,
+		"IS":           41,
+		"NULL":         42,
+		"EXISTS":       43,
+		"<>":           44,
+		"<=":           45,
+		">=":           46,
+		"string":       47,
+		"TRUE":         48,
+		"true":         49,
+		"FALSE":        50,
+		"false":        51,
+		"param":        52,
+		"null":         53,
+		"RETURN":       54,
+		"GROUP":        55,
+		"BY":           56,
+		"ORDER":        57,
+		"ASC":          58,
+		"DESC":         59,
+		"LIMIT":        60,
+		"SKIP":         61,
 	},
 }
@@ -2,6 +2,7 @@ package cypher
 
 import (
 	"fmt"
+	"math"
 	"strings"
 
 	"github.com/wouteroostervld/chainsaw/pkg/cypher/ast"
@@ -11,22 +12,114 @@ import (
 
 // TranspileOptions contains options for transpiling Cypher to SQL
 type TranspileOptions struct {
-	CWD string // Current working directory for path filtering (empty = no filtering)
+	CWD    string                 // Current working directory for path filtering (empty = no filtering)
+	Scope  Scope                  // Which end of each relation must lie under CWD
+	Params map[string]interface{} // Values for $parameters, bound as query args
+	RowCap int                    // Max rows per variable-length relation (0 = DefaultRowCap)
+	// Embed computes the embedding of a similar() or chainsaw.search text;
+	// queries using them fail without it
+	Embed func(text string) ([]float32, error)
+}
+
+// DefaultRowCap bounds the intermediate rows of a variable-length relation.
+// A query that exceeds it fails instead of running for minutes.
+const DefaultRowCap = 100000
+
+// rowCap returns the row cap in effect
+func (opts TranspileOptions) rowCap() int {
+	if opts.RowCap > 0 {
+		return opts.RowCap
+	}
+	return DefaultRowCap
+}
+
+// Scope selects which nodes of a relation are checked against the CWD
+type Scope string
+
+const (
+	ScopeEither Scope = ""       // Source or target file under CWD (default)
+	ScopeSource Scope = "source" // Source file under CWD
+	ScopeTarget Scope = "target" // Target file under CWD
+	ScopeBoth   Scope = "both"   // Source and target files under CWD
+)
+
+// ParseScope converts a command-line scope name into a Scope
+func ParseScope(name string) (Scope, error) {
+	switch strings.ToLower(name) {
+	case "", "either":
+		return ScopeEither, nil
+	case "source":
+		return ScopeSource, nil
+	case "target":
+		return ScopeTarget, nil
+	case "both":
+		return ScopeBoth, nil
+	default:
+		return "", fmt.Errorf("unknown scope %q (want either, source, target or both)", name)
+	}
+}
+
+// cwdCondition builds the path filter restricting a relation to files under
+// opts.CWD. sourceFile and targetFile are the file path columns of the
+// relation's source and target entities. Returns "" when no CWD is set.
+func cwdCondition(opts TranspileOptions, sourceFile, targetFile string) (string, []interface{}, error) {
+	if opts.CWD == "" {
+		return "", nil, nil
+	}
+	prefix := cwdPattern(opts.CWD)
+
+	switch opts.Scope {
+	case ScopeEither:
+		return fmt.Sprintf("(%s LIKE ? OR %s LIKE ?)", sourceFile, targetFile), []interface{}{prefix, prefix}, nil
+	case ScopeSource:
+		return sourceFile + " LIKE ?", []interface{}{prefix}, nil
+	case ScopeTarget:
+		return targetFile + " LIKE ?", []interface{}{prefix}, nil
+	case ScopeBoth:
+		return fmt.Sprintf("%s LIKE ? AND %s LIKE ?", sourceFile, targetFile), []interface{}{prefix, prefix}, nil
+	default:
+		return "", nil, fmt.Errorf("unknown scope: %s", opts.Scope)
+	}
 }
 
 // TranspileResult contains the generated SQL and bind parameters
 type TranspileResult struct {
-	SQL  string
+	SQL    string
+	Args   []interface{}
+	Lists  []string // result columns holding JSON arrays (COLLECT)
+	Mode   Mode     // EXPLAIN or PROFILE prefix of the query, if any
+	Stages []Stage  // one per CTE, in order, for PROFILE
+}
+
+// Mode is how a query asks to be run
+type Mode string
+
+const (
+	ModeRun     Mode = ""        // Run the query
+	ModeExplain Mode = "explain" // Show the SQL and query plan without running it
+	ModeProfile Mode = "profile" // Run the query and report rows and time per stage
+)
+
+// Stage counts the rows of one CTE of the generated SQL. Each stage
+// recomputes the CTEs before it, so its time includes theirs.
+type Stage struct {
+	Name string // CTE name, e.g. paths or stage1
+	SQL  string // SELECT COUNT(*) over the CTE
 	Args []interface{}
 }
 
+// cwdPattern returns the LIKE pattern matching files under dir
+func cwdPattern(dir string) string {
+	return strings.TrimSuffix(dir, "/") + "/%"
+}
+
 // Transpile converts a Cypher query to SQL with prepared statement placeholders
 func Transpile(query string, opts TranspileOptions) (*TranspileResult, error) {
 	// Parse Cypher using generated parser
 	lex := lexer.NewLexer([]byte(query))
 	parsedQuery, err := parser.NewParser().Parse(lex)
 	if err != nil {
-		return nil, fmt.Errorf("parse error: %w", err)
+		return nil, parseError(query, err)
 	}
 
 	// Type assert to our Query AST
@@ -36,172 +129,181 @@ func Transpile(query string, opts TranspileOptions) (*TranspileResult, error) {
 	}
 
 	// Generate SQL from AST
-	return generateSQL(q, opts)
+	result, err := generateSQL(q, opts)
+	if err != nil {
+		return nil, err
+	}
+	result.Mode = Mode(q.Mode)
+	return result, nil
 }
 
 // generateSQL walks the AST and builds SQL with placeholders
 func generateSQL(q *ast.Query, opts TranspileOptions) (*TranspileResult, error) {
-	// Extract components from AST
-	edge := q.Match.Pattern.Edge
-
-	// Check if this is a multi-hop query
-	if edge.MinHops > 0 || edge.MaxHops > 0 {
-		return generateMultiHopSQL(q, opts)
+	if len(q.Unions) > 0 {
+		return generateUnion(q, opts)
 	}
-
-	// Single-hop query (existing logic)
-	return generateSingleHopSQL(q, opts)
+	result, _, err := generateQuery(q, opts)
+	return result, err
 }
 
-// generateSingleHopSQL generates SQL for single-hop pattern matching
-func generateSingleHopSQL(q *ast.Query, opts TranspileOptions) (*TranspileResult, error) {
+// generateUnion combines the SQL of every UNION part. Parts must return the
+// same columns, by name and kind, as SQL would otherwise pair up unrelated
+// columns by position. A part that starts with its own CTEs or is sorted or
+// paged is wrapped in a subquery, which SQLite requires of compound SELECTs.
+func generateUnion(q *ast.Query, opts TranspileOptions) (*TranspileResult, error) {
+	parts := []*ast.UnionPart{{Query: q}}
+	parts = append(parts, q.Unions...)
+
 	var sql strings.Builder
 	var args []interface{}
+	var first *projection
+	lists := map[string]bool{}
+	var listNames []string
+	var stages []Stage
+	for i, part := range parts {
+		if i > 0 && part.All != parts[1].All {
+			return nil, fmt.Errorf("cannot mix UNION and UNION ALL in one query")
+		}
+		result, proj, err := generateQuery(part.Query, opts)
+		if err != nil {
+			return nil, err
+		}
+		if first == nil {
+			first = proj
+		} else if err := checkUnionColumns(first, proj, i+1); err != nil {
+			return nil, err
+		}
 
-	// Extract components from AST
-	pattern := q.Match.Pattern
-	sourceNode := pattern.SourceNode
-	edge := pattern.Edge
-	targetNode := pattern.TargetNode
-
-	// Handle direction - determines SQL JOIN but vars stay with their visual nodes
-	var e1Var, e2Var string     // Which variable maps to e1/e2 in SQL
-	var e1Label, e2Label string // Labels for SQL e1/e2
-
-	if edge.Direction == "->" {
-		// Forward: source (left) → target (right)
-		// SQL: e1 is source, e2 is target
-		e1Var = sourceNode.Variable
-		e1Label = sourceNode.Label
-		e2Var = targetNode.Variable
-		e2Label = targetNode.Label
-	} else if edge.Direction == "<-" {
-		// Backward: target (left) ← source (right)
-		// In SQL, e1 is always source_entity_id, e2 is target_entity_id
-		// But visually: left is target, right is source
-		e1Var = targetNode.Variable // Right node (source) maps to e1
-		e1Label = targetNode.Label
-		e2Var = sourceNode.Variable // Left node (target) maps to e2
-		e2Label = sourceNode.Label
-	} else {
-		// Undirected - not yet supported
-		return nil, fmt.Errorf("undirected edges not yet supported")
-	}
-
-	// Build SELECT clause
-	sql.WriteString("SELECT ")
-	selectItems := []string{}
-	for _, item := range q.Return.Items {
-		// Handle aggregate functions
-		if item.Aggregate != nil {
-			// Map aggregate variable to table alias
-			var tableAlias string
-			if item.Aggregate.Variable == e1Var {
-				tableAlias = "e1"
-			} else if item.Aggregate.Variable == e2Var {
-				tableAlias = "e2"
+		if i > 0 {
+			if part.All {
+				sql.WriteString("\nUNION ALL\n")
 			} else {
-				return nil, fmt.Errorf("unknown variable in aggregate: %s", item.Aggregate.Variable)
+				sql.WriteString("\nUNION\n")
 			}
-
-			// Build aggregate expression
-			var aggExpr string
-			switch item.Aggregate.Function {
-			case "COUNT":
-				aggExpr = fmt.Sprintf("COUNT(DISTINCT %s.id)", tableAlias)
-			case "SUM", "AVG", "MIN", "MAX":
-				return nil, fmt.Errorf("aggregate function %s not yet supported", item.Aggregate.Function)
-			default:
-				return nil, fmt.Errorf("unknown aggregate function: %s", item.Aggregate.Function)
-			}
-
-			// Add alias if specified
-			if item.Alias != "" {
-				aggExpr += " AS " + item.Alias
-			}
-			selectItems = append(selectItems, aggExpr)
-			continue
 		}
-
-		// Map variable to correct table alias
-		var tableAlias string
-		if item.Variable == e1Var {
-			tableAlias = "e1"
-		} else if item.Variable == e2Var {
-			tableAlias = "e2"
-		} else if item.Variable == "" {
-			// Empty variable in non-aggregate item - skip it
-			continue
+		if strings.HasPrefix(result.SQL, "WITH ") || part.Query.OrderBy != nil || part.Query.Limit != nil {
+			sql.WriteString("SELECT * FROM (\n  ")
+			sql.WriteString(strings.ReplaceAll(result.SQL, "\n", "\n  "))
+			sql.WriteString("\n)")
 		} else {
-			return nil, fmt.Errorf("unknown variable in RETURN: %s", item.Variable)
+			sql.WriteString(result.SQL)
 		}
-
-		// Build column reference
-		var colExpr string
-		if item.Property != "" {
-			// Specific property requested
-			if item.Property == "snippet" {
-				// Special case: get the code snippet from chunks
-				colExpr = fmt.Sprintf("c%s.content_snippet AS %s_snippet",
-					tableAlias[1:], item.Variable) // e1 -> c1, e2 -> c2
-			} else if item.Property == "file" {
-				// Special case: get the file path
-				colExpr = fmt.Sprintf("f%s.path AS %s_file",
-					tableAlias[1:], item.Variable)
-			} else if item.Property == "lines" {
-				// Special case: get line range
-				colExpr = fmt.Sprintf("(c%s.start_line || '-' || c%s.end_line) AS %s_lines",
-					tableAlias[1:], tableAlias[1:], item.Variable)
-			} else {
-				// Regular entity property
-				colExpr = fmt.Sprintf("%s.%s AS %s_%s",
-					tableAlias, item.Property, item.Variable, item.Property)
+		args = append(args, result.Args...)
+		for _, stage := range result.Stages {
+			stage.Name = fmt.Sprintf("%s (part %d)", stage.Name, i+1)
+			stages = append(stages, stage)
+		}
+		for _, name := range result.Lists {
+			if !lists[name] {
+				lists[name] = true
+				listNames = append(listNames, name)
 			}
-		} else {
-			// Return all entity columns
-			colExpr = fmt.Sprintf("%s.*", tableAlias)
 		}
-
-		selectItems = append(selectItems, colExpr)
 	}
-	sql.WriteString(strings.Join(selectItems, ", "))
-
-	// FROM clause with JOINs - include chunks and files for snippet access
-	sql.WriteString("\nFROM entities e1")
-	sql.WriteString("\nJOIN graph_edges g ON g.source_entity_id = e1.id")
-	sql.WriteString("\nJOIN entities e2 ON g.target_entity_id = e2.id")
 
-	// JOIN chunks for both entities to enable snippet/file queries
-	sql.WriteString("\nLEFT JOIN vec_chunks c1 ON e1.chunk_id = c1.chunk_id")
-	sql.WriteString("\nLEFT JOIN files f1 ON c1.file_id = f1.id")
-	sql.WriteString("\nLEFT JOIN vec_chunks c2 ON e2.chunk_id = c2.chunk_id")
-	sql.WriteString("\nLEFT JOIN files f2 ON c2.file_id = f2.id")
+	return &TranspileResult{
+		SQL:    sql.String(),
+		Args:   args,
+		Lists:  listNames,
+		Stages: stages,
+	}, nil
+}
 
-	// WHERE clause - add filters for labels and edge type
-	whereConditions := []string{}
+// checkUnionColumns compares the columns of UNION part n with the first part
+func checkUnionColumns(first, proj *projection, n int) error {
+	if strings.Join(first.columns, ", ") != strings.Join(proj.columns, ", ") {
+		return fmt.Errorf("UNION part %d returns columns (%s) but the first part returns (%s); use AS to give them the same names",
+			n, strings.Join(proj.columns, ", "), strings.Join(first.columns, ", "))
+	}
+	for i, kind := range proj.kinds {
+		if kind != first.kinds[i] {
+			return fmt.Errorf("UNION column %s is a %s in part %d but a %s in the first part", proj.columns[i], kind, n, first.kinds[i])
+		}
+	}
+	return nil
+}
 
-	// Source node label filter
-	if e1Label != "" {
-		whereConditions = append(whereConditions, "e1.entity_type = ?")
-		args = append(args, e1Label)
+// limitParameter resolves the $parameter given to SKIP or LIMIT, which must
+// be a non-negative whole number
+func limitParameter(clause string, param *ast.Parameter, params map[string]interface{}) (int64, error) {
+	value, err := parameterValue(param, params)
+	if err != nil {
+		return 0, err
+	}
+	switch n := value.(type) {
+	case int64:
+		if n >= 0 {
+			return n, nil
+		}
+	case float64:
+		if n >= 0 && n == math.Trunc(n) {
+			return int64(n), nil
+		}
 	}
+	return 0, fmt.Errorf("%s takes a non-negative whole number, but parameter $%s is %v", clause, param.Name, value)
+}
+
+// generateQuery builds the SQL of a single query and returns the projection
+// of its RETURN
+func generateQuery(q *ast.Query, opts TranspileOptions) (*TranspileResult, *projection, error) {
+	var sql strings.Builder
+	var args []interface{}
 
-	// Edge type filter
-	if edge.Type != "" {
-		whereConditions = append(whereConditions, "g.relation_type = ?")
-		args = append(args, edge.Type)
+	if q.Return == nil {
+		standalone, err := standaloneCall(q)
+		if err != nil {
+			return nil, nil, err
+		}
+		q = standalone
 	}
 
-	// Target node label filter
-	if e2Label != "" {
-		whereConditions = append(whereConditions, "e2.entity_type = ?")
-		args = append(args, e2Label)
+	p := &pipeline{opts: opts, call: q.Call, embeddings: map[string]string{}}
+	if q.Call != nil {
+		p.filter = q.Call.Where
+	}
+	for _, stage := range q.Stages {
+		if err := p.addStage(stage); err != nil {
+			return nil, nil, err
+		}
+	}
+	pj, whereConds, whereArgs, err := p.matchStage(q.Matches)
+	if err != nil {
+		return nil, nil, err
+	}
+	vars := pj.vars
+	proj, err := pj.project(q.Return.Items, false)
+	if err != nil {
+		return nil, nil, err
 	}
+	p.collect(pj)
 
-	if len(whereConditions) > 0 {
-		sql.WriteString("\nWHERE ")
-		sql.WriteString(strings.Join(whereConditions, "\n  AND "))
+	// CTEs for undirected and variable-length hops, then one per WITH stage
+	ctes := append(append([]cte{}, p.paths...), p.stages...)
+	if p.undirected {
+		ctes = append([]cte{{name: "undirected_edges", sql: undirectedEdgesCTE}}, ctes...)
+	}
+	recursive := false
+	for _, c := range p.paths {
+		recursive = recursive || c.recursive
 	}
+	var stages []Stage
+	if len(ctes) > 0 {
+		with, withArgs := withClause(ctes, recursive)
+		sql.WriteString(with)
+		sql.WriteString("\n")
+		args = append(args, withArgs...)
+
+		for i, c := range ctes {
+			with, withArgs := withClause(ctes[:i+1], recursive)
+			stages = append(stages, Stage{
+				Name: c.name,
+				SQL:  with + "\nSELECT COUNT(*) FROM " + c.name,
+				Args: withArgs,
+			})
+		}
+	}
+
+	args = append(args, pj.writeSelect(&sql, proj, pj.distinct || q.Return.Distinct, whereConds, whereArgs)...)
 
 	// GROUP BY clause
 	if q.GroupBy != nil {
@@ -209,17 +311,17 @@ func generateSingleHopSQL(q *ast.Query, opts TranspileOptions) (*TranspileResult
 		groupItems := []string{}
 		for _, item := range q.GroupBy.Items {
 			// Map variable to table alias
-			var tableAlias string
-			if item.Variable == e1Var {
-				tableAlias = "e1"
-			} else if item.Variable == e2Var {
-				tableAlias = "e2"
-			} else {
-				return nil, fmt.Errorf("unknown variable in GROUP BY: %s", item.Variable)
+			tableAlias, ok := vars[item.Variable]
+			if !ok {
+				return nil, nil, fmt.Errorf("unknown variable in GROUP BY: %s", item.Variable)
 			}
-			groupItems = append(groupItems, fmt.Sprintf("%s.%s", tableAlias, item.Property))
+			groupItems = append(groupItems, propertyColumn(tableAlias, item.Property))
 		}
 		sql.WriteString(strings.Join(groupItems, ", "))
+	} else if proj.hasAggregate && len(proj.groupKeys) > 0 {
+		// Like Cypher, group by every non-aggregate RETURN item
+		sql.WriteString("\nGROUP BY ")
+		sql.WriteString(strings.Join(proj.groupKeys, ", "))
 	}
 
 	// ORDER BY clause
@@ -237,146 +339,312 @@ func generateSingleHopSQL(q *ast.Query, opts TranspileOptions) (*TranspileResult
 		sql.WriteString(strings.Join(orderItems, ", "))
 	}
 
-	// LIMIT clause
+	// LIMIT clause (SQLite needs LIMIT -1 for an OFFSET without limit)
 	if q.Limit != nil {
-		sql.WriteString(fmt.Sprintf("\nLIMIT %d", q.Limit.Count))
+		if q.Limit.CountParam != nil {
+			count, err := limitParameter("LIMIT", q.Limit.CountParam, opts.Params)
+			if err != nil {
+				return nil, nil, err
+			}
+			sql.WriteString("\nLIMIT ?")
+			args = append(args, count)
+		} else {
+			sql.WriteString(fmt.Sprintf("\nLIMIT %d", q.Limit.Count))
+		}
+		if q.Limit.SkipParam != nil {
+			skip, err := limitParameter("SKIP", q.Limit.SkipParam, opts.Params)
+			if err != nil {
+				return nil, nil, err
+			}
+			sql.WriteString(" OFFSET ?")
+			args = append(args, skip)
+		} else if q.Limit.Skip > 0 {
+			sql.WriteString(fmt.Sprintf(" OFFSET %d", q.Limit.Skip))
+		}
 	}
 
 	return &TranspileResult{
-		SQL:  sql.String(),
-		Args: args,
-	}, nil
+		SQL:    sql.String(),
+		Args:   args,
+		Lists:  proj.lists,
+		Stages: stages,
+	}, proj, nil
 }
 
-// generateMultiHopSQL generates SQL with recursive CTE for multi-hop pattern matching
-func generateMultiHopSQL(q *ast.Query, opts TranspileOptions) (*TranspileResult, error) {
+// cte is one common table expression of the generated SQL
+type cte struct {
+	name      string
+	sql       string // name(...) AS (...)
+	args      []interface{}
+	recursive bool
+}
+
+// withClause renders CTEs as a WITH clause and returns their args
+func withClause(ctes []cte, recursive bool) (string, []interface{}) {
 	var sql strings.Builder
 	var args []interface{}
-
-	pattern := q.Match.Pattern
-	sourceNode := pattern.SourceNode
-	edge := pattern.Edge
-	targetNode := pattern.TargetNode
-
-	// Determine direction
-	var e1Var, e2Var string
-	var e1Label, e2Label string
-
-	if edge.Direction == "->" {
-		e1Var = sourceNode.Variable
-		e1Label = sourceNode.Label
-		e2Var = targetNode.Variable
-		e2Label = targetNode.Label
-	} else if edge.Direction == "<-" {
-		e1Var = targetNode.Variable
-		e1Label = targetNode.Label
-		e2Var = sourceNode.Variable
-		e2Label = sourceNode.Label
+	if recursive {
+		sql.WriteString("WITH RECURSIVE ")
 	} else {
-		return nil, fmt.Errorf("undirected edges not supported in multi-hop")
+		sql.WriteString("WITH ")
 	}
+	for i, c := range ctes {
+		if i > 0 {
+			sql.WriteString(",\n")
+		}
+		sql.WriteString(c.sql)
+		args = append(args, c.args...)
+	}
+	return sql.String(), args
+}
 
-	// Build recursive CTE
-	sql.WriteString("WITH RECURSIVE paths(source_id, target_id, depth) AS (\n")
+// projection is the column list of a RETURN or WITH
+type projection struct {
+	items        []string
+	groupKeys    []string // non-aggregate items, grouped on implicitly
+	hasAggregate bool
+	lists        []string        // columns holding JSON arrays (COLLECT)
+	columns      []string        // result column names, one per item
+	kinds        []string        // "node", "relationship" or "value", one per item
+	nodes        map[string]bool // WITH: columns holding entity ids
+}
 
-	// Base case: direct edges (depth 1)
-	sql.WriteString("  SELECT g.source_entity_id, g.target_entity_id, 1\n")
-	sql.WriteString("  FROM graph_edges g\n")
-	sql.WriteString("  JOIN entities e1 ON g.source_entity_id = e1.id\n")
-	sql.WriteString("  JOIN entities e2 ON g.target_entity_id = e2.id\n")
-	sql.WriteString("  WHERE 1=1\n")
+// addColumn records the name and kind of a projected item
+func (proj *projection) addColumn(name, kind string) {
+	proj.columns = append(proj.columns, name)
+	proj.kinds = append(proj.kinds, kind)
+}
 
-	// Add base case filters
-	if e1Label != "" {
-		sql.WriteString("    AND e1.entity_type = ?\n")
-		args = append(args, e1Label)
-	}
-	if edge.Type != "" {
-		sql.WriteString("    AND g.relation_type = ?\n")
-		args = append(args, edge.Type)
-	}
-	if e2Label != "" {
-		sql.WriteString("    AND e2.entity_type = ?\n")
-		args = append(args, e2Label)
-	}
+// project compiles RETURN or WITH items into select columns. A WITH passes
+// nodes on as their entity id and everything else as a named value.
+func (pj *patternJoins) project(items []ast.ReturnItem, with bool) (*projection, error) {
+	proj := &projection{nodes: map[string]bool{}}
+	for _, item := range items {
+		name := item.Alias
+		if with {
+			var err error
+			if name, err = withName(item); err != nil {
+				return nil, err
+			}
+		}
 
-	sql.WriteString("\n  UNION ALL\n\n")
+		// Handle aggregate functions
+		if item.Aggregate != nil {
+			aggExpr, err := pj.aggregateColumn(item.Aggregate)
+			if err != nil {
+				return nil, err
+			}
+			if name == "" {
+				name = aggregateName(item.Aggregate)
+			}
+			if item.Aggregate.Function == "COLLECT" {
+				proj.lists = append(proj.lists, name)
+			}
+			proj.items = append(proj.items, aggExpr+" AS "+name)
+			proj.addColumn(name, "value")
+			proj.hasAggregate = true
+			continue
+		}
 
-	// Recursive case: extend paths
-	sql.WriteString("  SELECT p.source_id, g.target_entity_id, p.depth + 1\n")
-	sql.WriteString("  FROM paths p\n")
-	sql.WriteString("  JOIN graph_edges g ON p.target_id = g.source_entity_id\n")
+		// Empty variable in non-aggregate item - skip it
+		if item.Variable == "" {
+			continue
+		}
 
-	// Add edge type filter for recursive step
-	if edge.Type != "" {
-		sql.WriteString("  WHERE g.relation_type = ?\n")
-		args = append(args, edge.Type)
-	}
+		// Scalar function such as type(r)
+		if item.Function != "" {
+			column, err := pj.functionColumn(item.Function, item.Variable, item.Argument)
+			if err != nil {
+				return nil, err
+			}
+			if name == "" {
+				name = item.Function + "_" + item.Variable
+			}
+			if item.Function == "nodes" {
+				proj.lists = append(proj.lists, name)
+			}
+			proj.items = append(proj.items, fmt.Sprintf("%s AS %s", column, name))
+			proj.addColumn(name, "value")
+			proj.groupKeys = append(proj.groupKeys, column)
+			continue
+		}
 
-	// Add max depth constraint
-	maxDepth := edge.MaxHops
-	if maxDepth == 0 {
-		maxDepth = 10 // Default
-	}
-	if edge.Type != "" {
-		sql.WriteString("    AND p.depth < ?\n")
-	} else {
-		sql.WriteString("  WHERE p.depth < ?\n")
-	}
-	args = append(args, maxDepth)
+		// Value passed on by an earlier WITH
+		if column, ok := pj.scalars[item.Variable]; ok {
+			if item.Property != "" {
+				return nil, fmt.Errorf("%s is not a node or relationship, so it has no property %s", item.Variable, item.Property)
+			}
+			if name == "" {
+				name = item.Variable
+			}
+			if pj.listVars[item.Variable] {
+				proj.lists = append(proj.lists, name)
+			}
+			proj.items = append(proj.items, fmt.Sprintf("%s AS %s", column, name))
+			proj.addColumn(name, "value")
+			proj.groupKeys = append(proj.groupKeys, column)
+			continue
+		}
 
-	sql.WriteString(")\n")
+		if _, ok := pj.depths[item.Variable]; ok {
+			return nil, fmt.Errorf("variable-length relation %s can only be returned through length(%s)", item.Variable, item.Variable)
+		}
 
-	// Main SELECT from the CTE
-	sql.WriteString("SELECT DISTINCT ")
-	selectItems := []string{}
-	for _, item := range q.Return.Items {
-		var tableAlias string
-		if item.Variable == e1Var {
-			tableAlias = "e1"
-		} else if item.Variable == e2Var {
-			tableAlias = "e2"
-		} else {
+		// A whole path is returned as its list of node names
+		if _, ok := pj.paths[item.Variable]; ok {
+			if with {
+				return nil, fmt.Errorf("path variable %s cannot be passed on by WITH; pass nodes(%s) or length(%s) instead", item.Variable, item.Variable, item.Variable)
+			}
+			if item.Property != "" {
+				return nil, fmt.Errorf("path variable %s has no properties; use nodes(%s) or length(%s)", item.Variable, item.Variable, item.Variable)
+			}
+			column, _ := pj.functionColumn("nodes", item.Variable, nil)
+			if name == "" {
+				name = item.Variable
+			}
+			proj.items = append(proj.items, fmt.Sprintf("%s AS %s", column, name))
+			proj.addColumn(name, "value")
+			proj.lists = append(proj.lists, name)
+			proj.groupKeys = append(proj.groupKeys, column)
+			continue
+		}
+
+		// Map variable to correct table alias
+		tableAlias, ok := pj.vars[item.Variable]
+		if !ok {
 			return nil, fmt.Errorf("unknown variable in RETURN: %s", item.Variable)
 		}
 
-		if item.Property != "" {
-			if item.Property == "snippet" {
-				selectItems = append(selectItems, fmt.Sprintf("c%s.content_snippet AS %s_snippet",
-					tableAlias[1:], item.Variable))
-			} else if item.Property == "file" {
-				selectItems = append(selectItems, fmt.Sprintf("f%s.path AS %s_file",
-					tableAlias[1:], item.Variable))
-			} else if item.Property == "lines" {
-				selectItems = append(selectItems, fmt.Sprintf("(c%s.start_line || '-' || c%s.end_line) AS %s_lines",
-					tableAlias[1:], tableAlias[1:], item.Variable))
-			} else {
-				selectItems = append(selectItems, fmt.Sprintf("%s.%s AS %s_%s",
-					tableAlias, item.Property, item.Variable, item.Property))
+		// Build column reference
+		var colExpr string
+		switch {
+		case item.Property != "":
+			// Specific property requested (snippet/file/lines come from chunks)
+			if name == "" {
+				name = item.Variable + "_" + item.Property
 			}
-		} else {
-			selectItems = append(selectItems, fmt.Sprintf("%s.*", tableAlias))
+			colExpr = fmt.Sprintf("%s AS %s", propertyColumn(tableAlias, item.Property), name)
+			proj.addColumn(name, "value")
+			proj.groupKeys = append(proj.groupKeys, propertyColumn(tableAlias, item.Property))
+		case isRelationshipAlias(tableAlias) && with:
+			return nil, fmt.Errorf("relationship variable %s cannot be passed on by WITH; pass its properties instead", item.Variable)
+		case with:
+			// Nodes are passed on by id and joined back by the next stage
+			colExpr = fmt.Sprintf("%s.id AS %s", tableAlias, name)
+			proj.groupKeys = append(proj.groupKeys, tableAlias+".id")
+			proj.addColumn(name, "node")
+			proj.nodes[name] = true
+		case isRelationshipAlias(tableAlias):
+			// Return all entity columns
+			colExpr = fmt.Sprintf("%s.*", tableAlias)
+			proj.groupKeys = append(proj.groupKeys, fmt.Sprintf("%[1]s.source_entity_id, %[1]s.target_entity_id, %[1]s.chunk_id", tableAlias))
+			proj.addColumn(item.Variable, "relationship")
+		default:
+			colExpr = fmt.Sprintf("%s.*", tableAlias)
+			proj.groupKeys = append(proj.groupKeys, tableAlias+".id")
+			proj.addColumn(item.Variable, "node")
 		}
+
+		proj.items = append(proj.items, colExpr)
 	}
-	sql.WriteString(strings.Join(selectItems, ", "))
+	return proj, nil
+}
 
-	// FROM the CTE result joined with entities
-	sql.WriteString("\nFROM paths p")
-	sql.WriteString("\nJOIN entities e1 ON p.source_id = e1.id")
-	sql.WriteString("\nJOIN entities e2 ON p.target_id = e2.id")
-	sql.WriteString("\nLEFT JOIN vec_chunks c1 ON e1.chunk_id = c1.chunk_id")
-	sql.WriteString("\nLEFT JOIN files f1 ON c1.file_id = f1.id")
-	sql.WriteString("\nLEFT JOIN vec_chunks c2 ON e2.chunk_id = c2.chunk_id")
-	sql.WriteString("\nLEFT JOIN files f2 ON c2.file_id = f2.id")
+// writeSelect renders SELECT ... FROM ... WHERE for a projection and returns
+// its args
+func (pj *patternJoins) writeSelect(sql *strings.Builder, proj *projection, distinct bool, whereConds []string, whereArgs []interface{}) []interface{} {
+	var args []interface{}
 
-	// Add min depth constraint if specified
-	if edge.MinHops > 0 {
-		sql.WriteString("\nWHERE p.depth >= ?")
-		args = append(args, edge.MinHops)
+	// Build SELECT clause
+	sql.WriteString("SELECT ")
+	if distinct {
+		sql.WriteString("DISTINCT ")
 	}
+	sql.WriteString(strings.Join(proj.items, ", "))
 
-	return &TranspileResult{
-		SQL:  sql.String(),
-		Args: args,
-	}, nil
+	// FROM clause with JOINs - include chunks and files for snippet access
+	for _, line := range pj.fromClause() {
+		sql.WriteString("\n")
+		sql.WriteString(line)
+	}
+	args = append(args, pj.joinArgs...)
+
+	// WHERE clause - CWD scope first, then labels, edge types and depths
+	whereConditions := append([]string{}, pj.scope...)
+	whereConditions = append(whereConditions, pj.conds...)
+	args = append(args, pj.scopeArg...)
+	args = append(args, pj.condArgs...)
+
+	whereConditions = append(whereConditions, whereConds...)
+	args = append(args, whereArgs...)
+
+	if len(whereConditions) > 0 {
+		sql.WriteString("\nWHERE ")
+		sql.WriteString(strings.Join(whereConditions, "\n  AND "))
+	}
+	return args
+}
+
+// aggregateColumn renders an aggregate RETURN item. COUNT of a node counts
+// the rows it is bound in, COUNT(DISTINCT n) the distinct entities; COLLECT
+// gathers values into a JSON array, skipping NULLs and collecting nodes by
+// name. Values passed on by WITH are aggregated as they are.
+func (pj *patternJoins) aggregateColumn(agg *ast.AggregateFunction) (string, error) {
+	if agg.Variable == "" {
+		return "COUNT(*)", nil
+	}
+	scalar, isScalar := pj.scalars[agg.Variable]
+	tableAlias, ok := pj.vars[agg.Variable]
+	if !ok && !isScalar {
+		return "", fmt.Errorf("unknown variable in aggregate: %s", agg.Variable)
+	}
+
+	var column string
+	switch {
+	case isScalar && agg.Property != "":
+		return "", fmt.Errorf("%s is not a node or relationship, so it has no property %s", agg.Variable, agg.Property)
+	case isScalar:
+		column = scalar
+	case agg.Property != "":
+		column = propertyColumn(tableAlias, agg.Property)
+	case agg.Distinct && isRelationshipAlias(tableAlias):
+		return "", fmt.Errorf("%s(DISTINCT %s) is not supported for relationships, aggregate a property instead, e.g. %s(DISTINCT %s.type)",
+			agg.Function, agg.Variable, agg.Function, agg.Variable)
+	case agg.Function == "COUNT" && !isRelationshipAlias(tableAlias):
+		column = tableAlias + ".id"
+	case agg.Function == "COUNT" || agg.Function == "COLLECT":
+		// Whole nodes are represented by name, relationships by type
+		column = propertyColumn(tableAlias, "name")
+		if isRelationshipAlias(tableAlias) {
+			column = propertyColumn(tableAlias, "type")
+		}
+	default:
+		return "", fmt.Errorf("%s requires a property, e.g. %s(%s.weight)", agg.Function, agg.Function, agg.Variable)
+	}
+
+	arg := column
+	if agg.Distinct {
+		arg = "DISTINCT " + column
+	}
+	switch agg.Function {
+	case "COUNT", "SUM", "AVG", "MIN", "MAX":
+		return fmt.Sprintf("%s(%s)", agg.Function, arg), nil
+	case "COLLECT":
+		return fmt.Sprintf("json_group_array(%s) FILTER (WHERE %s IS NOT NULL)", arg, column), nil
+	default:
+		return "", fmt.Errorf("unknown aggregate function: %s", agg.Function)
+	}
+}
+
+// aggregateName is the default column name of an aggregate, e.g.
+// count_n or sum_r_weight
+func aggregateName(agg *ast.AggregateFunction) string {
+	name := strings.ToLower(agg.Function)
+	if agg.Variable != "" {
+		name += "_" + agg.Variable
+	}
+	if agg.Property != "" {
+		name += "_" + agg.Property
+	}
+	return name
 }
@@ -1,6 +1,7 @@
 package cypher
 
 import (
+	"fmt"
 	"strings"
 	"testing"
 )
@@ -101,6 +102,7 @@ func TestTranspileWithCWD(t *testing.T) {
 		name     string
 		cypher   string
 		cwd      string
+		scope    Scope
 		wantSQL  string
 		wantArgs []interface{}
 	}{
@@ -121,11 +123,88 @@ WHERE (f1.path LIKE ? OR f2.path LIKE ?)
   AND g.relation_type = ?`,
 			wantArgs: []interface{}{"/home/user/project/pkg/db/%", "/home/user/project/pkg/db/%", "FUNCTION", "calls"},
 		},
+		{
+			name:   "scope source",
+			cypher: "MATCH (f)-[:calls]->(t) RETURN f.name",
+			cwd:    "/home/user/project/",
+			scope:  ScopeSource,
+			wantSQL: `SELECT e1.name AS f_name
+FROM entities e1
+JOIN graph_edges g ON g.source_entity_id = e1.id
+JOIN entities e2 ON g.target_entity_id = e2.id
+LEFT JOIN vec_chunks c1 ON e1.chunk_id = c1.chunk_id
+LEFT JOIN files f1 ON c1.file_id = f1.id
+LEFT JOIN vec_chunks c2 ON e2.chunk_id = c2.chunk_id
+LEFT JOIN files f2 ON c2.file_id = f2.id
+WHERE f1.path LIKE ?
+  AND g.relation_type = ?`,
+			wantArgs: []interface{}{"/home/user/project/%", "calls"},
+		},
+		{
+			name:   "scope target follows relation direction",
+			cypher: "MATCH (t)<-[:calls]-(f) RETURN f.name",
+			cwd:    "/home/user/project",
+			scope:  ScopeTarget,
+			wantSQL: `SELECT e1.name AS f_name
+FROM entities e1
+JOIN graph_edges g ON g.source_entity_id = e1.id
+JOIN entities e2 ON g.target_entity_id = e2.id
+LEFT JOIN vec_chunks c1 ON e1.chunk_id = c1.chunk_id
+LEFT JOIN files f1 ON c1.file_id = f1.id
+LEFT JOIN vec_chunks c2 ON e2.chunk_id = c2.chunk_id
+LEFT JOIN files f2 ON c2.file_id = f2.id
+WHERE f2.path LIKE ?
+  AND g.relation_type = ?`,
+			wantArgs: []interface{}{"/home/user/project/%", "calls"},
+		},
+		{
+			name:   "scope both on multi-hop",
+			cypher: "MATCH (a)-[:calls*2]->(b) RETURN b.name",
+			cwd:    "/home/user/project",
+			scope:  ScopeBoth,
+			wantSQL: `WITH RECURSIVE paths(source_id, target_id, depth, edges) AS (
+  SELECT g.source_entity_id, g.target_entity_id, 1, CAST(g.rowid AS TEXT)
+  FROM graph_edges g
+  JOIN entities e1 ON g.source_entity_id = e1.id
+  JOIN entities e2 ON g.target_entity_id = e2.id
+  WHERE 1=1
+    AND g.relation_type = ?
+
+  UNION ALL
+
+  SELECT p.source_id, g.target_entity_id, p.depth + 1, p.edges || ',' || g.rowid
+  FROM paths p
+  CROSS JOIN graph_edges g ON p.target_id = g.source_entity_id
+  WHERE g.relation_type = ?
+    AND p.depth < ?
+    AND instr(',' || p.edges || ',', ',' || g.rowid || ',') = 0
+  LIMIT ?
+)
+SELECT DISTINCT e2.name AS b_name
+FROM entities e1
+JOIN paths p ON p.source_id = e1.id
+JOIN entities e2 ON p.target_id = e2.id
+LEFT JOIN vec_chunks c1 ON e1.chunk_id = c1.chunk_id
+LEFT JOIN files f1 ON c1.file_id = f1.id
+LEFT JOIN vec_chunks c2 ON e2.chunk_id = c2.chunk_id
+LEFT JOIN files f2 ON c2.file_id = f2.id
+WHERE f1.path LIKE ? AND f2.path LIKE ?
+  AND p.depth >= ?
+  AND (SELECT row_cap(COUNT(*), ?) FROM paths)`,
+			wantArgs: []interface{}{"calls", "calls", 2, DefaultRowCap + 1, "/home/user/project/%", "/home/user/project/%", 2, DefaultRowCap},
+		},
+		{
+			name:     "no CWD means no filter",
+			cypher:   "MATCH (f)-[:calls]->(t) RETURN f.name",
+			scope:    ScopeBoth,
+			wantSQL:  "SELECT e1.name AS f_name\nFROM entities e1\nJOIN graph_edges g ON g.source_entity_id = e1.id\nJOIN entities e2 ON g.target_entity_id = e2.id\nLEFT JOIN vec_chunks c1 ON e1.chunk_id = c1.chunk_id\nLEFT JOIN files f1 ON c1.file_id = f1.id\nLEFT JOIN vec_chunks c2 ON e2.chunk_id = c2.chunk_id\nLEFT JOIN files f2 ON c2.file_id = f2.id\nWHERE g.relation_type = ?",
+			wantArgs: []interface{}{"calls"},
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			opts := TranspileOptions{CWD: tt.cwd}
+			opts := TranspileOptions{CWD: tt.cwd, Scope: tt.scope}
 			result, err := Transpile(tt.cypher, opts)
 			if err != nil {
 				t.Fatalf("Transpile() error = %v", err)
@@ -213,7 +292,7 @@ func TestAggregateTranspile(t *testing.T) {
 		{
 			name:  "count with group by",
 			query: "MATCH (a)-[:calls]->(b) RETURN b.name, COUNT(a) AS callers GROUP BY b.name",
-			wantSQL: `SELECT e2.name AS b_name, COUNT(DISTINCT e1.id) AS callers
+			wantSQL: `SELECT e2.name AS b_name, COUNT(e1.id) AS callers
 FROM entities e1
 JOIN graph_edges g ON g.source_entity_id = e1.id
 JOIN entities e2 ON g.target_entity_id = e2.id
@@ -227,7 +306,7 @@ GROUP BY e2.name`,
 		},
 		{
 			name:  "count with order by and limit",
-			query: "MATCH (a)-[:calls]->(b) RETURN b.name, COUNT(a) AS callers GROUP BY b.name ORDER BY callers DESC LIMIT 10",
+			query: "MATCH (a)-[:calls]->(b) RETURN b.name, COUNT(DISTINCT a) AS callers GROUP BY b.name ORDER BY callers DESC LIMIT 10",
 			wantSQL: `SELECT e2.name AS b_name, COUNT(DISTINCT e1.id) AS callers
 FROM entities e1
 JOIN graph_edges g ON g.source_entity_id = e1.id
@@ -265,3 +344,1885 @@ LIMIT 10`,
 		})
 	}
 }
+
+func TestTranspileWhere(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		wantSQL  string
+		wantArgs []interface{}
+		wantErr  bool
+	}{
+		{
+			name:  "property equality on target",
+			query: "MATCH (f:FUNCTION)-[:calls]->(t) WHERE t.name = 'IndexFile' RETURN f.name",
+			wantSQL: `SELECT e1.name AS f_name
+FROM entities e1
+JOIN graph_edges g ON g.source_entity_id = e1.id
+JOIN entities e2 ON g.target_entity_id = e2.id
+LEFT JOIN vec_chunks c1 ON e1.chunk_id = c1.chunk_id
+LEFT JOIN files f1 ON c1.file_id = f1.id
+LEFT JOIN vec_chunks c2 ON e2.chunk_id = c2.chunk_id
+LEFT JOIN files f2 ON c2.file_id = f2.id
+WHERE e1.entity_type = ?
+  AND g.relation_type = ?
+  AND e2.name = ?`,
+			wantArgs: []interface{}{"FUNCTION", "calls", "IndexFile"},
+		},
+		{
+			name:  "boolean operators, IN and IS NULL",
+			query: `MATCH (a)-[:calls]->(b) WHERE NOT a.name IN ["main", "init"] AND (b.entity_type <> 'TYPE' OR b.chunk_id IS NULL) RETURN a.name`,
+			wantSQL: `SELECT e1.name AS a_name
+FROM entities e1
+JOIN graph_edges g ON g.source_entity_id = e1.id
+JOIN entities e2 ON g.target_entity_id = e2.id
+LEFT JOIN vec_chunks c1 ON e1.chunk_id = c1.chunk_id
+LEFT JOIN files f1 ON c1.file_id = f1.id
+LEFT JOIN vec_chunks c2 ON e2.chunk_id = c2.chunk_id
+LEFT JOIN files f2 ON c2.file_id = f2.id
+WHERE g.relation_type = ?
+  AND (NOT (e1.name IN (?, ?)) AND (e2.entity_type <> ? OR e2.chunk_id IS NULL))`,
+			wantArgs: []interface{}{"calls", "main", "init", "TYPE"},
+		},
+		{
+			name:  "numeric literals and virtual properties",
+			query: "MATCH (a)<-[:uses]-(b) WHERE b.id >= -3 AND a.file = 'x.go' AND b.chunk_id < 2.5 RETURN a.name",
+			wantSQL: `SELECT e2.name AS a_name
+FROM entities e1
+JOIN graph_edges g ON g.source_entity_id = e1.id
+JOIN entities e2 ON g.target_entity_id = e2.id
+LEFT JOIN vec_chunks c1 ON e1.chunk_id = c1.chunk_id
+LEFT JOIN files f1 ON c1.file_id = f1.id
+LEFT JOIN vec_chunks c2 ON e2.chunk_id = c2.chunk_id
+LEFT JOIN files f2 ON c2.file_id = f2.id
+WHERE g.relation_type = ?
+  AND ((e1.id >= ? AND f2.path = ?) AND e1.chunk_id < ?)`,
+			wantArgs: []interface{}{"uses", int64(-3), "x.go", 2.5},
+		},
+		{
+			name:    "unknown variable",
+			query:   "MATCH (a)-[:calls]->(b) WHERE c.name = 'x' RETURN a.name",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Transpile(tt.query, TranspileOptions{})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Transpile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			if result.SQL != tt.wantSQL {
+				t.Errorf("SQL mismatch:\nGot:\n%s\n\nWant:\n%s", result.SQL, tt.wantSQL)
+			}
+
+			if len(result.Args) != len(tt.wantArgs) {
+				t.Fatalf("Args = %v, want %v", result.Args, tt.wantArgs)
+			}
+			for i, arg := range result.Args {
+				if arg != tt.wantArgs[i] {
+					t.Errorf("Arg[%d] = %v, want %v", i, arg, tt.wantArgs[i])
+				}
+			}
+		})
+	}
+}
+
+func TestMultiHopWhere(t *testing.T) {
+	result, err := Transpile(`MATCH (a:FUNCTION)-[:calls*1..3]->(b) WHERE b.name = "InsertChunk" RETURN a.name`, TranspileOptions{})
+	if err != nil {
+		t.Fatalf("Transpile failed: %v", err)
+	}
+
+	if !strings.HasSuffix(result.SQL, "WHERE p.depth >= ?\n  AND (SELECT row_cap(COUNT(*), ?) FROM paths)\n  AND e2.name = ?") {
+		t.Errorf("Expected depth and name conditions, got:\n%s", result.SQL)
+	}
+	if last := result.Args[len(result.Args)-1]; last != "InsertChunk" {
+		t.Errorf("Last arg = %v, want InsertChunk", last)
+	}
+}
+
+func TestTranspilePathChain(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		wantSQL  string
+		wantArgs []interface{}
+	}{
+		{
+			name:  "two fixed hops",
+			query: "MATCH (h:FUNCTION)-[:calls]->(s)-[:uses]->(t:STRUCT) RETURN h.name, s.name, t.name",
+			wantSQL: `SELECT e1.name AS h_name, e2.name AS s_name, e3.name AS t_name
+FROM entities e1
+JOIN graph_edges g ON g.source_entity_id = e1.id
+JOIN entities e2 ON g.target_entity_id = e2.id
+JOIN graph_edges g2 ON g2.source_entity_id = e2.id
+JOIN entities e3 ON g2.target_entity_id = e3.id
+LEFT JOIN vec_chunks c1 ON e1.chunk_id = c1.chunk_id
+LEFT JOIN files f1 ON c1.file_id = f1.id
+LEFT JOIN vec_chunks c2 ON e2.chunk_id = c2.chunk_id
+LEFT JOIN files f2 ON c2.file_id = f2.id
+LEFT JOIN vec_chunks c3 ON e3.chunk_id = c3.chunk_id
+LEFT JOIN files f3 ON c3.file_id = f3.id
+WHERE e1.entity_type = ?
+  AND g.relation_type = ?
+  AND g2.relation_type = ?
+  AND e3.entity_type = ?`,
+			wantArgs: []interface{}{"FUNCTION", "calls", "uses", "STRUCT"},
+		},
+		{
+			name:  "fixed hop then backward variable-length hop",
+			query: "MATCH (a:FUNCTION)-[:calls]->(b)<-[:uses*1..2]-(c:TYPE) RETURN c.name",
+			wantSQL: `WITH RECURSIVE paths2(source_id, target_id, depth, edges) AS (
+  SELECT g.source_entity_id, g.target_entity_id, 1, CAST(g.rowid AS TEXT)
+  FROM graph_edges g
+  JOIN entities e1 ON g.source_entity_id = e1.id
+  JOIN entities e2 ON g.target_entity_id = e2.id
+  WHERE 1=1
+    AND e2.id IN (
+      SELECT e2.id
+      FROM entities e1
+      JOIN graph_edges g ON g.source_entity_id = e1.id
+      JOIN entities e2 ON g.target_entity_id = e2.id
+      LEFT JOIN vec_chunks c1 ON e1.chunk_id = c1.chunk_id
+      LEFT JOIN files f1 ON c1.file_id = f1.id
+      LEFT JOIN vec_chunks c2 ON e2.chunk_id = c2.chunk_id
+      LEFT JOIN files f2 ON c2.file_id = f2.id
+      WHERE e1.entity_type = ?
+        AND g.relation_type = ?
+    )
+    AND g.relation_type = ?
+
+  UNION ALL
+
+  SELECT g.source_entity_id, p.target_id, p.depth + 1, p.edges || ',' || g.rowid
+  FROM paths2 p
+  CROSS JOIN graph_edges g ON g.target_entity_id = p.source_id
+  WHERE g.relation_type = ?
+    AND p.depth < ?
+    AND instr(',' || p.edges || ',', ',' || g.rowid || ',') = 0
+  LIMIT ?
+)
+SELECT DISTINCT e3.name AS c_name
+FROM entities e1
+JOIN graph_edges g ON g.source_entity_id = e1.id
+JOIN entities e2 ON g.target_entity_id = e2.id
+JOIN paths2 p2 ON p2.target_id = e2.id
+JOIN entities e3 ON p2.source_id = e3.id
+LEFT JOIN vec_chunks c1 ON e1.chunk_id = c1.chunk_id
+LEFT JOIN files f1 ON c1.file_id = f1.id
+LEFT JOIN vec_chunks c2 ON e2.chunk_id = c2.chunk_id
+LEFT JOIN files f2 ON c2.file_id = f2.id
+LEFT JOIN vec_chunks c3 ON e3.chunk_id = c3.chunk_id
+LEFT JOIN files f3 ON c3.file_id = f3.id
+WHERE e1.entity_type = ?
+  AND g.relation_type = ?
+  AND e3.entity_type = ?
+  AND p2.depth >= ?
+  AND (SELECT row_cap(COUNT(*), ?) FROM paths2)`,
+			wantArgs: []interface{}{"FUNCTION", "calls", "uses", "uses", 2, DefaultRowCap + 1, "FUNCTION", "calls", "TYPE", 1, DefaultRowCap},
+		},
+		{
+			name:  "anonymous middle node",
+			query: "MATCH (a:FUNCTION)-[:calls]->()-[:uses]->(:STRUCT) RETURN a.name",
+			wantSQL: `SELECT e1.name AS a_name
+FROM entities e1
+JOIN graph_edges g ON g.source_entity_id = e1.id
+JOIN entities e2 ON g.target_entity_id = e2.id
+JOIN graph_edges g2 ON g2.source_entity_id = e2.id
+JOIN entities e3 ON g2.target_entity_id = e3.id
+LEFT JOIN vec_chunks c1 ON e1.chunk_id = c1.chunk_id
+LEFT JOIN files f1 ON c1.file_id = f1.id
+LEFT JOIN vec_chunks c2 ON e2.chunk_id = c2.chunk_id
+LEFT JOIN files f2 ON c2.file_id = f2.id
+LEFT JOIN vec_chunks c3 ON e3.chunk_id = c3.chunk_id
+LEFT JOIN files f3 ON c3.file_id = f3.id
+WHERE e1.entity_type = ?
+  AND g.relation_type = ?
+  AND g2.relation_type = ?
+  AND e3.entity_type = ?`,
+			wantArgs: []interface{}{"FUNCTION", "calls", "uses", "STRUCT"},
+		},
+		{
+			name:  "anonymous middle node between unlabeled ends",
+			query: "MATCH (a)-[:calls]->()-[:uses]->(b) RETURN b.name",
+			wantSQL: `SELECT e3.name AS b_name
+FROM entities e1
+JOIN graph_edges g ON g.source_entity_id = e1.id
+JOIN entities e2 ON g.target_entity_id = e2.id
+JOIN graph_edges g2 ON g2.source_entity_id = e2.id
+JOIN entities e3 ON g2.target_entity_id = e3.id
+LEFT JOIN vec_chunks c1 ON e1.chunk_id = c1.chunk_id
+LEFT JOIN files f1 ON c1.file_id = f1.id
+LEFT JOIN vec_chunks c2 ON e2.chunk_id = c2.chunk_id
+LEFT JOIN files f2 ON c2.file_id = f2.id
+LEFT JOIN vec_chunks c3 ON e3.chunk_id = c3.chunk_id
+LEFT JOIN files f3 ON c3.file_id = f3.id
+WHERE g.relation_type = ?
+  AND g2.relation_type = ?`,
+			wantArgs: []interface{}{"calls", "uses"},
+		},
+		{
+			name:  "repeated variable closes a cycle",
+			query: "MATCH (a)-[:calls]->(b)-[:calls]->(a) RETURN a.name",
+			wantSQL: `SELECT e1.name AS a_name
+FROM entities e1
+JOIN graph_edges g ON g.source_entity_id = e1.id
+JOIN entities e2 ON g.target_entity_id = e2.id
+JOIN graph_edges g2 ON g2.source_entity_id = e2.id AND g2.target_entity_id = e1.id
+LEFT JOIN vec_chunks c1 ON e1.chunk_id = c1.chunk_id
+LEFT JOIN files f1 ON c1.file_id = f1.id
+LEFT JOIN vec_chunks c2 ON e2.chunk_id = c2.chunk_id
+LEFT JOIN files f2 ON c2.file_id = f2.id
+WHERE g.relation_type = ?
+  AND g2.relation_type = ?`,
+			wantArgs: []interface{}{"calls", "calls"},
+		},
+		{
+			name:  "single node",
+			query: "MATCH (f:FUNCTION) RETURN f.name",
+			wantSQL: `SELECT e1.name AS f_name
+FROM entities e1
+LEFT JOIN vec_chunks c1 ON e1.chunk_id = c1.chunk_id
+LEFT JOIN files f1 ON c1.file_id = f1.id
+WHERE e1.entity_type = ?`,
+			wantArgs: []interface{}{"FUNCTION"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Transpile(tt.query, TranspileOptions{})
+			if err != nil {
+				t.Fatalf("Transpile() error = %v", err)
+			}
+
+			if result.SQL != tt.wantSQL {
+				t.Errorf("SQL mismatch:\nGot:\n%s\n\nWant:\n%s", result.SQL, tt.wantSQL)
+			}
+
+			if len(result.Args) != len(tt.wantArgs) {
+				t.Fatalf("Args = %v, want %v", result.Args, tt.wantArgs)
+			}
+			for i, arg := range result.Args {
+				if arg != tt.wantArgs[i] {
+					t.Errorf("Arg[%d] = %v, want %v", i, arg, tt.wantArgs[i])
+				}
+			}
+		})
+	}
+}
+
+func TestTranspileMultipleMatch(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		wantSQL  string
+		wantArgs []interface{}
+	}{
+		{
+			name:  "comma-separated patterns share a variable",
+			query: "MATCH (s:STRUCT)-[:implements]->(i:INTERFACE), (c:FUNCTION)-[:creates]->(s) WHERE c.name = 'NewServer' RETURN s.name, i.name",
+			wantSQL: `SELECT e1.name AS s_name, e2.name AS i_name
+FROM entities e1
+JOIN graph_edges g ON g.source_entity_id = e1.id
+JOIN entities e2 ON g.target_entity_id = e2.id
+JOIN graph_edges g2 ON g2.target_entity_id = e1.id
+JOIN entities e3 ON g2.source_entity_id = e3.id
+LEFT JOIN vec_chunks c1 ON e1.chunk_id = c1.chunk_id
+LEFT JOIN files f1 ON c1.file_id = f1.id
+LEFT JOIN vec_chunks c2 ON e2.chunk_id = c2.chunk_id
+LEFT JOIN files f2 ON c2.file_id = f2.id
+LEFT JOIN vec_chunks c3 ON e3.chunk_id = c3.chunk_id
+LEFT JOIN files f3 ON c3.file_id = f3.id
+WHERE e1.entity_type = ?
+  AND g.relation_type = ?
+  AND e2.entity_type = ?
+  AND e3.entity_type = ?
+  AND g2.relation_type = ?
+  AND e3.name = ?`,
+			wantArgs: []interface{}{"STRUCT", "implements", "INTERFACE", "FUNCTION", "creates", "NewServer"},
+		},
+		{
+			name:  "consecutive MATCH clauses each with WHERE",
+			query: "MATCH (a)-[:calls]->(b) WHERE a.name = 'main' MATCH (x)-[:uses]->(y)<-[:calls]-(b:FUNCTION) WHERE y.name <> 'x' RETURN x.name",
+			wantSQL: `SELECT e4.name AS x_name
+FROM entities e1
+JOIN graph_edges g ON g.source_entity_id = e1.id
+JOIN entities e2 ON g.target_entity_id = e2.id
+JOIN graph_edges g2 ON g2.source_entity_id = e2.id
+JOIN entities e3 ON g2.target_entity_id = e3.id
+JOIN graph_edges g3 ON g3.target_entity_id = e3.id
+JOIN entities e4 ON g3.source_entity_id = e4.id
+LEFT JOIN vec_chunks c1 ON e1.chunk_id = c1.chunk_id
+LEFT JOIN files f1 ON c1.file_id = f1.id
+LEFT JOIN vec_chunks c2 ON e2.chunk_id = c2.chunk_id
+LEFT JOIN files f2 ON c2.file_id = f2.id
+LEFT JOIN vec_chunks c3 ON e3.chunk_id = c3.chunk_id
+LEFT JOIN files f3 ON c3.file_id = f3.id
+LEFT JOIN vec_chunks c4 ON e4.chunk_id = c4.chunk_id
+LEFT JOIN files f4 ON c4.file_id = f4.id
+WHERE g.relation_type = ?
+  AND e2.entity_type = ?
+  AND g2.relation_type = ?
+  AND g3.relation_type = ?
+  AND e1.name = ?
+  AND e3.name <> ?`,
+			wantArgs: []interface{}{"calls", "FUNCTION", "calls", "uses", "main", "x"},
+		},
+		{
+			name:  "later pattern reaches a bound variable through anonymous nodes",
+			query: "MATCH (i:INTERFACE) MATCH (a:FUNCTION)-[:calls]->()-[:uses]->()-[:implements]->(i) RETURN a.name, i.name",
+			wantSQL: `SELECT e4.name AS a_name, e1.name AS i_name
+FROM entities e1
+JOIN graph_edges g ON g.target_entity_id = e1.id
+JOIN entities e2 ON g.source_entity_id = e2.id
+JOIN graph_edges g2 ON g2.target_entity_id = e2.id
+JOIN entities e3 ON g2.source_entity_id = e3.id
+JOIN graph_edges g3 ON g3.target_entity_id = e3.id
+JOIN entities e4 ON g3.source_entity_id = e4.id
+LEFT JOIN vec_chunks c1 ON e1.chunk_id = c1.chunk_id
+LEFT JOIN files f1 ON c1.file_id = f1.id
+LEFT JOIN vec_chunks c2 ON e2.chunk_id = c2.chunk_id
+LEFT JOIN files f2 ON c2.file_id = f2.id
+LEFT JOIN vec_chunks c3 ON e3.chunk_id = c3.chunk_id
+LEFT JOIN files f3 ON c3.file_id = f3.id
+LEFT JOIN vec_chunks c4 ON e4.chunk_id = c4.chunk_id
+LEFT JOIN files f4 ON c4.file_id = f4.id
+WHERE e1.entity_type = ?
+  AND g.relation_type = ?
+  AND g2.relation_type = ?
+  AND e4.entity_type = ?
+  AND g3.relation_type = ?`,
+			wantArgs: []interface{}{"INTERFACE", "implements", "uses", "FUNCTION", "calls"},
+		},
+		{
+			name:  "disconnected pattern is cross joined",
+			query: "MATCH (a)-[:calls]->(b), (t:STRUCT) RETURN a.name, t.name",
+			wantSQL: `SELECT e1.name AS a_name, e3.name AS t_name
+FROM entities e1
+JOIN graph_edges g ON g.source_entity_id = e1.id
+JOIN entities e2 ON g.target_entity_id = e2.id
+CROSS JOIN entities e3
+LEFT JOIN vec_chunks c1 ON e1.chunk_id = c1.chunk_id
+LEFT JOIN files f1 ON c1.file_id = f1.id
+LEFT JOIN vec_chunks c2 ON e2.chunk_id = c2.chunk_id
+LEFT JOIN files f2 ON c2.file_id = f2.id
+LEFT JOIN vec_chunks c3 ON e3.chunk_id = c3.chunk_id
+LEFT JOIN files f3 ON c3.file_id = f3.id
+WHERE g.relation_type = ?
+  AND e3.entity_type = ?`,
+			wantArgs: []interface{}{"calls", "STRUCT"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Transpile(tt.query, TranspileOptions{})
+			if err != nil {
+				t.Fatalf("Transpile() error = %v", err)
+			}
+
+			if result.SQL != tt.wantSQL {
+				t.Errorf("SQL mismatch:\nGot:\n%s\n\nWant:\n%s", result.SQL, tt.wantSQL)
+			}
+
+			if len(result.Args) != len(tt.wantArgs) {
+				t.Fatalf("Args = %v, want %v", result.Args, tt.wantArgs)
+			}
+			for i, arg := range result.Args {
+				if arg != tt.wantArgs[i] {
+					t.Errorf("Arg[%d] = %v, want %v", i, arg, tt.wantArgs[i])
+				}
+			}
+		})
+	}
+}
+
+func TestTranspileOptionalMatch(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		wantSQL  string
+		wantArgs []interface{}
+		wantErr  bool
+	}{
+		{
+			name:  "optional relation with WHERE",
+			query: "MATCH (s:STRUCT) OPTIONAL MATCH (s)-[:implements]->(i:INTERFACE) WHERE i.file = 'x.go' RETURN s.name, i.name",
+			wantSQL: `SELECT e1.name AS s_name, e2.name AS i_name
+FROM entities e1
+LEFT JOIN vec_chunks c1 ON e1.chunk_id = c1.chunk_id
+LEFT JOIN files f1 ON c1.file_id = f1.id
+LEFT JOIN (
+  graph_edges g
+  JOIN entities e2 ON g.target_entity_id = e2.id
+  LEFT JOIN vec_chunks c2 ON e2.chunk_id = c2.chunk_id
+  LEFT JOIN files f2 ON c2.file_id = f2.id
+) ON g.source_entity_id = e1.id
+  AND g.relation_type = ?
+  AND e2.entity_type = ?
+  AND f2.path = ?
+WHERE e1.entity_type = ?`,
+			wantArgs: []interface{}{"implements", "INTERFACE", "x.go", "STRUCT"},
+		},
+		{
+			name:  "optional chain matches as a whole",
+			query: "MATCH (s:STRUCT) OPTIONAL MATCH (s)-[:implements]->(i)<-[:implements]-(o) RETURN s.name, o.name",
+			wantSQL: `SELECT e1.name AS s_name, e3.name AS o_name
+FROM entities e1
+LEFT JOIN vec_chunks c1 ON e1.chunk_id = c1.chunk_id
+LEFT JOIN files f1 ON c1.file_id = f1.id
+LEFT JOIN (
+  graph_edges g
+  JOIN entities e2 ON g.target_entity_id = e2.id
+  JOIN graph_edges g2 ON g2.target_entity_id = e2.id
+  JOIN entities e3 ON g2.source_entity_id = e3.id
+  LEFT JOIN vec_chunks c2 ON e2.chunk_id = c2.chunk_id
+  LEFT JOIN files f2 ON c2.file_id = f2.id
+  LEFT JOIN vec_chunks c3 ON e3.chunk_id = c3.chunk_id
+  LEFT JOIN files f3 ON c3.file_id = f3.id
+) ON g.source_entity_id = e1.id
+  AND g.relation_type = ?
+  AND g2.relation_type = ?
+WHERE e1.entity_type = ?`,
+			wantArgs: []interface{}{"implements", "implements", "STRUCT"},
+		},
+		{
+			name:    "leading OPTIONAL MATCH",
+			query:   "OPTIONAL MATCH (s)-[:implements]->(i) RETURN s.name",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Transpile(tt.query, TranspileOptions{})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Transpile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			if result.SQL != tt.wantSQL {
+				t.Errorf("SQL mismatch:\nGot:\n%s\n\nWant:\n%s", result.SQL, tt.wantSQL)
+			}
+
+			if len(result.Args) != len(tt.wantArgs) {
+				t.Fatalf("Args = %v, want %v", result.Args, tt.wantArgs)
+			}
+			for i, arg := range result.Args {
+				if arg != tt.wantArgs[i] {
+					t.Errorf("Arg[%d] = %v, want %v", i, arg, tt.wantArgs[i])
+				}
+			}
+		})
+	}
+}
+
+func TestTranspileUndirected(t *testing.T) {
+	result, err := Transpile("MATCH (t:STRUCT)-[:uses]-(x) RETURN t.name, x.name", TranspileOptions{})
+	if err != nil {
+		t.Fatalf("Transpile() error = %v", err)
+	}
+
+	wantSQL := `WITH undirected_edges(source_entity_id, target_entity_id, relation_type, chunk_id, weight, edge_id) AS (
+  SELECT source_entity_id, target_entity_id, relation_type, chunk_id, weight, rowid FROM graph_edges
+  UNION ALL
+  SELECT target_entity_id, source_entity_id, relation_type, chunk_id, weight, rowid FROM graph_edges
+)
+SELECT e1.name AS t_name, e2.name AS x_name
+FROM entities e1
+JOIN undirected_edges g ON g.source_entity_id = e1.id
+JOIN entities e2 ON g.target_entity_id = e2.id
+LEFT JOIN vec_chunks c1 ON e1.chunk_id = c1.chunk_id
+LEFT JOIN files f1 ON c1.file_id = f1.id
+LEFT JOIN vec_chunks c2 ON e2.chunk_id = c2.chunk_id
+LEFT JOIN files f2 ON c2.file_id = f2.id
+WHERE e1.entity_type = ?
+  AND g.relation_type = ?`
+	if result.SQL != wantSQL {
+		t.Errorf("SQL mismatch:\nGot:\n%s\n\nWant:\n%s", result.SQL, wantSQL)
+	}
+
+	// Variable-length undirected hops recurse over the same view, here
+	// backward from the labeled end
+	result, err = Transpile("MATCH (a)-[:uses*1..2]-(b:TYPE) RETURN b.name", TranspileOptions{})
+	if err != nil {
+		t.Fatalf("Transpile() error = %v", err)
+	}
+	if !strings.HasPrefix(result.SQL, "WITH RECURSIVE undirected_edges(") {
+		t.Errorf("Expected undirected_edges CTE first, got:\n%s", result.SQL)
+	}
+	if !strings.Contains(result.SQL, "  CROSS JOIN undirected_edges g ON g.target_entity_id = p.source_id\n") {
+		t.Errorf("Expected recursion over undirected_edges, got:\n%s", result.SQL)
+	}
+}
+
+func TestTranspileRelTypeAlternation(t *testing.T) {
+	result, err := Transpile("MATCH (f:FUNCTION)-[:calls|uses|creates]->(t) RETURN f.name, t.name", TranspileOptions{})
+	if err != nil {
+		t.Fatalf("Transpile() error = %v", err)
+	}
+	if !strings.HasSuffix(result.SQL, "WHERE e1.entity_type = ?\n  AND g.relation_type IN (?, ?, ?)") {
+		t.Errorf("Expected relation_type IN, got:\n%s", result.SQL)
+	}
+	wantArgs := []interface{}{"FUNCTION", "calls", "uses", "creates"}
+	if len(result.Args) != len(wantArgs) {
+		t.Fatalf("Args = %v, want %v", result.Args, wantArgs)
+	}
+	for i, arg := range result.Args {
+		if arg != wantArgs[i] {
+			t.Errorf("Arg[%d] = %v, want %v", i, arg, wantArgs[i])
+		}
+	}
+
+	// Both the base case and the recursive step of a multi-hop CTE filter on the alternation
+	result, err = Transpile("MATCH (a)-[:calls|uses*1..3]->(b) RETURN b.name", TranspileOptions{})
+	if err != nil {
+		t.Fatalf("Transpile() error = %v", err)
+	}
+	if !strings.Contains(result.SQL, "    AND g.relation_type IN (?, ?)\n") {
+		t.Errorf("Expected alternation in base case, got:\n%s", result.SQL)
+	}
+	if !strings.Contains(result.SQL, "  WHERE g.relation_type IN (?, ?)\n    AND p.depth < ?\n") {
+		t.Errorf("Expected alternation in recursive step, got:\n%s", result.SQL)
+	}
+}
+
+func TestTranspileInlineProperties(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		wantSQL  string
+		wantArgs []interface{}
+	}{
+		{
+			name:     "labeled node with map",
+			query:    "MATCH (f:FUNCTION {name: 'ProcessGraphBatch'})-[:calls]->(t) RETURN t.name",
+			wantSQL:  "WHERE e1.entity_type = ?\n  AND e1.name = ?\n  AND g.relation_type = ?",
+			wantArgs: []interface{}{"FUNCTION", "ProcessGraphBatch", "calls"},
+		},
+		{
+			name:     "virtual file property",
+			query:    "MATCH (f {file: '/abs/path.go'}) RETURN f.name",
+			wantSQL:  "WHERE f1.path = ?",
+			wantArgs: []interface{}{"/abs/path.go"},
+		},
+		{
+			name:     "anonymous target with several entries",
+			query:    "MATCH (f:FUNCTION)-[:calls]->({name: 'Open', lines: '10-20'}) RETURN f.name",
+			wantSQL:  "WHERE e1.entity_type = ?\n  AND g.relation_type = ?\n  AND e2.name = ?\n  AND (c2.start_line || '-' || c2.end_line) = ?",
+			wantArgs: []interface{}{"FUNCTION", "calls", "Open", "10-20"},
+		},
+		{
+			name:     "map combined with WHERE",
+			query:    "MATCH (f:FUNCTION {name: 'main'}) WHERE f.file <> 'x.go' RETURN f.name",
+			wantSQL:  "WHERE e1.entity_type = ?\n  AND e1.name = ?\n  AND f1.path <> ?",
+			wantArgs: []interface{}{"FUNCTION", "main", "x.go"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Transpile(tt.query, TranspileOptions{})
+			if err != nil {
+				t.Fatalf("Transpile() error = %v", err)
+			}
+			if !strings.HasSuffix(result.SQL, tt.wantSQL) {
+				t.Errorf("SQL does not end with:\n%s\ngot:\n%s", tt.wantSQL, result.SQL)
+			}
+			if len(result.Args) != len(tt.wantArgs) {
+				t.Fatalf("Args = %v, want %v", result.Args, tt.wantArgs)
+			}
+			for i, arg := range result.Args {
+				if arg != tt.wantArgs[i] {
+					t.Errorf("Arg[%d] = %v, want %v", i, arg, tt.wantArgs[i])
+				}
+			}
+		})
+	}
+}
+
+func TestTranspileRelationshipVariables(t *testing.T) {
+	result, err := Transpile("MATCH (a:FUNCTION)-[r]->(b) RETURN a.name, type(r), r.weight, r.file AS where_seen, r.lines, r.chunk", TranspileOptions{})
+	if err != nil {
+		t.Fatalf("Transpile() error = %v", err)
+	}
+	expected := `SELECT e1.name AS a_name, g.relation_type AS type_r, g.weight AS r_weight, fg.path AS where_seen, (cg.start_line || '-' || cg.end_line) AS r_lines, cg.content_snippet AS r_chunk
+FROM entities e1
+JOIN graph_edges g ON g.source_entity_id = e1.id
+JOIN entities e2 ON g.target_entity_id = e2.id
+LEFT JOIN vec_chunks c1 ON e1.chunk_id = c1.chunk_id
+LEFT JOIN files f1 ON c1.file_id = f1.id
+LEFT JOIN vec_chunks c2 ON e2.chunk_id = c2.chunk_id
+LEFT JOIN files f2 ON c2.file_id = f2.id
+LEFT JOIN vec_chunks cg ON g.chunk_id = cg.chunk_id
+LEFT JOIN files fg ON cg.file_id = fg.id
+WHERE e1.entity_type = ?`
+	if result.SQL != expected {
+		t.Errorf("SQL mismatch.\nExpected:\n%s\n\nGot:\n%s", expected, result.SQL)
+	}
+
+	tests := []struct {
+		name    string
+		query   string
+		wantSQL string
+		wantErr bool
+	}{
+		{
+			name:    "type() in WHERE",
+			query:   "MATCH (a)-[r:calls|uses]->(b) WHERE type(r) = 'uses' RETURN a.name",
+			wantSQL: "g.relation_type = ?",
+		},
+		{
+			name:    "second hop is g2",
+			query:   "MATCH (a)-[:calls]->(b)<-[r:uses]-(c) RETURN r.type",
+			wantSQL: "SELECT g2.relation_type AS r_type",
+		},
+		{
+			name:    "group by relation type",
+			query:   "MATCH (a)-[r]->(b) RETURN r.type, COUNT(a) AS n GROUP BY r.type",
+			wantSQL: "GROUP BY g.relation_type",
+		},
+		{
+			name:    "type() of a node",
+			query:   "MATCH (a)-[r]->(b) RETURN type(a)",
+			wantErr: true,
+		},
+		{
+			name:    "relationship used as node",
+			query:   "MATCH (a)-[r]->(b), (r)-[:calls]->(c) RETURN a.name",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Transpile(tt.query, TranspileOptions{})
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Expected error, got SQL:\n%s", result.SQL)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Transpile() error = %v", err)
+			}
+			if !strings.Contains(result.SQL, tt.wantSQL) {
+				t.Errorf("SQL does not contain %q:\n%s", tt.wantSQL, result.SQL)
+			}
+		})
+	}
+}
+
+func TestTranspileAggregateFunctions(t *testing.T) {
+	tests := []struct {
+		name      string
+		query     string
+		wantSQL   []string
+		wantLists []string
+		wantErr   bool
+	}{
+		{
+			name:    "count star",
+			query:   "MATCH (a)-[:calls]->(b) RETURN COUNT(*)",
+			wantSQL: []string{"SELECT COUNT(*) AS count\n"},
+		},
+		{
+			name:    "count property",
+			query:   "MATCH (a)-[:calls]->(b) RETURN count(b.name) AS n",
+			wantSQL: []string{"SELECT COUNT(e2.name) AS n\n"},
+		},
+		{
+			name:    "sum avg min max",
+			query:   "MATCH (a)-[r]->(b) RETURN SUM(r.weight), AVG(r.weight), MIN(b.name), MAX(b.lines)",
+			wantSQL: []string{"SELECT SUM(g.weight) AS sum_r_weight, AVG(g.weight) AS avg_r_weight, MIN(e2.name) AS min_b_name, MAX((c2.start_line || '-' || c2.end_line)) AS max_b_lines\n"},
+		},
+		{
+			name:  "collect with implicit grouping",
+			query: "MATCH (i:INTERFACE)<-[:implements]-(s) RETURN i.name, COLLECT(s.name) AS implementers",
+			wantSQL: []string{
+				"SELECT e2.name AS i_name, json_group_array(e1.name) FILTER (WHERE e1.name IS NOT NULL) AS implementers\n",
+				"\nGROUP BY e2.name",
+			},
+			wantLists: []string{"implementers"},
+		},
+		{
+			name:      "collect nodes by name",
+			query:     "MATCH (a)-[:calls*1..2]->(b) RETURN a.name, collect(b)",
+			wantSQL:   []string{"json_group_array(e2.name) FILTER (WHERE e2.name IS NOT NULL) AS collect_b", "\nGROUP BY e1.name"},
+			wantLists: []string{"collect_b"},
+		},
+		{
+			name:    "explicit GROUP BY wins",
+			query:   "MATCH (a)-[:calls]->(b) RETURN b.name, b.file, COUNT(a) AS callers GROUP BY b.name",
+			wantSQL: []string{"\nGROUP BY e2.name"},
+		},
+		{
+			name:    "count node counts rows",
+			query:   "MATCH (a)-[:calls]->(b) RETURN b.name, COUNT(a) AS callers",
+			wantSQL: []string{"SELECT e2.name AS b_name, COUNT(e1.id) AS callers\n"},
+		},
+		{
+			name:    "count distinct node",
+			query:   "MATCH (a)-[:calls]->(b) RETURN b.name, count(DISTINCT a) AS callers",
+			wantSQL: []string{"SELECT e2.name AS b_name, COUNT(DISTINCT e1.id) AS callers\n"},
+		},
+		{
+			name:      "collect distinct property",
+			query:     "MATCH (a)-[:calls]->(b) RETURN a.name, COLLECT(DISTINCT b.file) AS files",
+			wantSQL:   []string{"json_group_array(DISTINCT f2.path) FILTER (WHERE f2.path IS NOT NULL) AS files"},
+			wantLists: []string{"files"},
+		},
+		{
+			name:    "distinct outside an aggregate",
+			query:   "MATCH (a) RETURN type(DISTINCT a)",
+			wantErr: true,
+		},
+		{
+			name:    "count distinct relationship",
+			query:   "MATCH (a)-[r]->(b) RETURN COUNT(DISTINCT r)",
+			wantErr: true,
+		},
+		{
+			name:    "sum needs a property",
+			query:   "MATCH (a)-[:calls]->(b) RETURN SUM(a)",
+			wantErr: true,
+		},
+		{
+			name:    "only count takes star",
+			query:   "MATCH (a) RETURN MAX(*)",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Transpile(tt.query, TranspileOptions{})
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Expected error, got SQL:\n%s", result.SQL)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Transpile() error = %v", err)
+			}
+			for _, want := range tt.wantSQL {
+				if !strings.Contains(result.SQL, want) {
+					t.Errorf("SQL does not contain %q:\n%s", want, result.SQL)
+				}
+			}
+			if strings.Join(result.Lists, ",") != strings.Join(tt.wantLists, ",") {
+				t.Errorf("Lists = %v, want %v", result.Lists, tt.wantLists)
+			}
+		})
+	}
+}
+
+func TestTranspileDistinctSkip(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		wantPrefix string
+		wantSuffix string
+	}{
+		{
+			name:       "return distinct",
+			query:      "MATCH (a)-[:calls]->(b) RETURN DISTINCT b.name",
+			wantPrefix: "SELECT DISTINCT e2.name AS b_name\n",
+			wantSuffix: "WHERE g.relation_type = ?",
+		},
+		{
+			name:       "skip and limit",
+			query:      "MATCH (a)-[:calls]->(b) RETURN a.name, b.name ORDER BY a_name SKIP 20 LIMIT 10",
+			wantPrefix: "SELECT e1.name AS a_name",
+			wantSuffix: "\nORDER BY a_name ASC\nLIMIT 10 OFFSET 20",
+		},
+		{
+			name:       "skip only",
+			query:      "MATCH (n:FUNCTION) RETURN DISTINCT n.name SKIP 5",
+			wantPrefix: "SELECT DISTINCT e1.name AS n_name\n",
+			wantSuffix: "\nLIMIT -1 OFFSET 5",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Transpile(tt.query, TranspileOptions{})
+			if err != nil {
+				t.Fatalf("Transpile() error = %v", err)
+			}
+			if !strings.HasPrefix(result.SQL, tt.wantPrefix) || !strings.HasSuffix(result.SQL, tt.wantSuffix) {
+				t.Errorf("SQL should start with %q and end with %q, got:\n%s", tt.wantPrefix, tt.wantSuffix, result.SQL)
+			}
+		})
+	}
+}
+
+func TestTranspileParameters(t *testing.T) {
+	params := map[string]interface{}{
+		"name":  "it's quoted",
+		"min":   int64(2),
+		"names": []interface{}{"a", "b"},
+		"none":  nil,
+		"page":  float64(20),
+		"half":  1.5,
+		"neg":   int64(-1),
+	}
+	tests := []struct {
+		name     string
+		query    string
+		wantSQL  string
+		wantArgs []interface{}
+		wantErr  bool
+	}{
+		{
+			name:     "where and inline map",
+			query:    "MATCH (f:FUNCTION {name: $name})-[r]->(t) WHERE r.weight >= $min RETURN t.name",
+			wantSQL:  "WHERE e1.entity_type = ?\n  AND e1.name = ?\n  AND g.weight >= ?",
+			wantArgs: []interface{}{"FUNCTION", "it's quoted", int64(2)},
+		},
+		{
+			name:     "list parameter with IN",
+			query:    "MATCH (f) WHERE f.name IN $names RETURN f.name",
+			wantSQL:  "WHERE e1.name IN (?, ?)",
+			wantArgs: []interface{}{"a", "b"},
+		},
+		{
+			name:    "null parameter",
+			query:   "MATCH (f) WHERE f.name = $none RETURN f.name",
+			wantSQL: "WHERE e1.name = NULL",
+		},
+		{
+			name:    "missing parameter",
+			query:   "MATCH (f) WHERE f.name = $other RETURN f.name",
+			wantErr: true,
+		},
+		{
+			name:    "list parameter outside IN",
+			query:   "MATCH (f) WHERE f.name = $names RETURN f.name",
+			wantErr: true,
+		},
+		{
+			name:     "skip and limit",
+			query:    "MATCH (f:FUNCTION) RETURN f.name SKIP $page LIMIT $min",
+			wantSQL:  "WHERE e1.entity_type = ?\nLIMIT ? OFFSET ?",
+			wantArgs: []interface{}{"FUNCTION", int64(2), int64(20)},
+		},
+		{
+			name:     "skip only",
+			query:    "MATCH (f) RETURN f.name SKIP $min",
+			wantSQL:  "\nLIMIT -1 OFFSET ?",
+			wantArgs: []interface{}{int64(2)},
+		},
+		{
+			name:     "limit after a literal skip",
+			query:    "MATCH (f) RETURN f.name SKIP 5 LIMIT $page",
+			wantSQL:  "\nLIMIT ? OFFSET 5",
+			wantArgs: []interface{}{int64(20)},
+		},
+		{
+			name:    "fractional limit",
+			query:   "MATCH (f) RETURN f.name LIMIT $half",
+			wantErr: true,
+		},
+		{
+			name:    "negative skip",
+			query:   "MATCH (f) RETURN f.name SKIP $neg",
+			wantErr: true,
+		},
+		{
+			name:    "text limit",
+			query:   "MATCH (f) RETURN f.name LIMIT $name",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Transpile(tt.query, TranspileOptions{Params: params})
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Expected error, got SQL:\n%s", result.SQL)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Transpile() error = %v", err)
+			}
+			if !strings.HasSuffix(result.SQL, tt.wantSQL) {
+				t.Errorf("SQL does not end with:\n%s\ngot:\n%s", tt.wantSQL, result.SQL)
+			}
+			if len(result.Args) != len(tt.wantArgs) {
+				t.Fatalf("Args = %v, want %v", result.Args, tt.wantArgs)
+			}
+			for i, arg := range result.Args {
+				if arg != tt.wantArgs[i] {
+					t.Errorf("Arg[%d] = %v, want %v", i, arg, tt.wantArgs[i])
+				}
+			}
+		})
+	}
+}
+
+func TestTranspileStringPredicates(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		wantSQL  string
+		wantArgs []interface{}
+	}{
+		{
+			name:     "starts with",
+			query:    "MATCH (f:FUNCTION) WHERE f.name STARTS WITH 'Handle' RETURN f.name",
+			wantSQL:  "instr(e1.name, ?) = 1",
+			wantArgs: []interface{}{"FUNCTION", "Handle"},
+		},
+		{
+			name:     "ends with repeats both operands",
+			query:    "MATCH (f) WHERE f.file ENDS WITH $suffix RETURN f.name",
+			wantSQL:  "substr(f1.path, length(f1.path) - length(?) + 1) = ?",
+			wantArgs: []interface{}{"_test.go", "_test.go"},
+		},
+		{
+			name:     "contains",
+			query:    "MATCH (a)-[:calls]->(b) WHERE b.file CONTAINS 'pkg/db' RETURN a.name",
+			wantSQL:  "instr(f2.path, ?) > 0",
+			wantArgs: []interface{}{"calls", "pkg/db"},
+		},
+		{
+			name:     "regex keeps unknown escapes",
+			query:    `MATCH (e) WHERE e.file =~ '.*_test\.go' RETURN e.name`,
+			wantSQL:  "f1.path REGEXP ?",
+			wantArgs: []interface{}{`.*_test\.go`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Transpile(tt.query, TranspileOptions{Params: map[string]interface{}{"suffix": "_test.go"}})
+			if err != nil {
+				t.Fatalf("Transpile() error = %v", err)
+			}
+			if !strings.HasSuffix(result.SQL, tt.wantSQL) {
+				t.Errorf("SQL does not end with %q:\n%s", tt.wantSQL, result.SQL)
+			}
+			if len(result.Args) != len(tt.wantArgs) {
+				t.Fatalf("Args = %v, want %v", result.Args, tt.wantArgs)
+			}
+			for i, arg := range result.Args {
+				if arg != tt.wantArgs[i] {
+					t.Errorf("Arg[%d] = %v, want %v", i, arg, tt.wantArgs[i])
+				}
+			}
+		})
+	}
+}
+
+func TestTranspilePatternPredicates(t *testing.T) {
+	result, err := Transpile("MATCH (f:FUNCTION) WHERE NOT (f)<-[:calls]-() RETURN f.name", TranspileOptions{})
+	if err != nil {
+		t.Fatalf("Transpile() error = %v", err)
+	}
+	expected := `SELECT e1.name AS f_name
+FROM entities e1
+LEFT JOIN vec_chunks c1 ON e1.chunk_id = c1.chunk_id
+LEFT JOIN files f1 ON c1.file_id = f1.id
+WHERE e1.entity_type = ?
+  AND NOT (EXISTS (
+    SELECT 1
+    FROM graph_edges g
+    JOIN entities e2 ON g.source_entity_id = e2.id
+    LEFT JOIN vec_chunks c2 ON e2.chunk_id = c2.chunk_id
+    LEFT JOIN files f2 ON c2.file_id = f2.id
+    WHERE g.target_entity_id = e1.id
+      AND g.relation_type = ?
+  ))`
+	if result.SQL != expected {
+		t.Errorf("SQL mismatch.\nExpected:\n%s\n\nGot:\n%s", expected, result.SQL)
+	}
+
+	tests := []struct {
+		name     string
+		query    string
+		wantSQL  []string
+		wantArgs []interface{}
+		wantErr  bool
+	}{
+		{
+			name:  "exists subquery with where",
+			query: "MATCH (i:INTERFACE) WHERE NOT EXISTS { MATCH (i)<-[:implements]-(s:STRUCT) WHERE s.name <> $skip } RETURN i.name",
+			wantSQL: []string{
+				"    WHERE g.target_entity_id = e1.id\n      AND e2.entity_type = ?\n      AND g.relation_type = ?\n      AND e2.name <> ?\n",
+			},
+			wantArgs: []interface{}{"INTERFACE", "STRUCT", "implements", "x"},
+		},
+		{
+			name:  "aliases continue after the subquery",
+			query: "MATCH (a)-[:calls]->(b) WHERE (b)-[:uses]->(:STRUCT) RETURN a.name",
+			wantSQL: []string{
+				"    FROM graph_edges g2\n    JOIN entities e3 ON g2.target_entity_id = e3.id\n",
+				"    WHERE g2.source_entity_id = e2.id\n",
+			},
+			wantArgs: []interface{}{"calls", "uses", "STRUCT"},
+		},
+		{
+			name:  "variable-length predicate hoists its CTE",
+			query: "MATCH (f:FUNCTION) WHERE (f)-[:calls*1..3]->(:INTERFACE) RETURN f.name",
+			wantSQL: []string{
+				"WITH RECURSIVE paths(source_id, target_id, depth, edges) AS (\n",
+				"    FROM paths p\n    JOIN entities e2 ON p.target_id = e2.id\n",
+			},
+			wantArgs: []interface{}{"FUNCTION", "calls", "calls", 3, DefaultRowCap + 1, "FUNCTION", 1, DefaultRowCap, "INTERFACE"},
+		},
+		{
+			name:    "predicate without a relation",
+			query:   "MATCH (f) WHERE EXISTS { MATCH (f) } RETURN f.name",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Transpile(tt.query, TranspileOptions{Params: map[string]interface{}{"skip": "x"}})
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Expected error, got SQL:\n%s", result.SQL)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Transpile() error = %v", err)
+			}
+			for _, want := range tt.wantSQL {
+				if !strings.Contains(result.SQL, want) {
+					t.Errorf("SQL does not contain %q:\n%s", want, result.SQL)
+				}
+			}
+			if len(result.Args) != len(tt.wantArgs) {
+				t.Fatalf("Args = %v, want %v", result.Args, tt.wantArgs)
+			}
+			for i, arg := range result.Args {
+				if arg != tt.wantArgs[i] {
+					t.Errorf("Arg[%d] = %v, want %v", i, arg, tt.wantArgs[i])
+				}
+			}
+		})
+	}
+}
+
+func TestTranspileWith(t *testing.T) {
+	result, err := Transpile("MATCH (f)-[:calls]->(t) WITH t, COUNT(f) AS callers WHERE callers > 5 MATCH (t)-[:uses]->(x) RETURN t.name, x.name", TranspileOptions{})
+	if err != nil {
+		t.Fatalf("Transpile() error = %v", err)
+	}
+	expected := `WITH stage1 AS (
+  SELECT e2.id AS t, COUNT(e1.id) AS callers
+  FROM entities e1
+  JOIN graph_edges g ON g.source_entity_id = e1.id
+  JOIN entities e2 ON g.target_entity_id = e2.id
+  LEFT JOIN vec_chunks c1 ON e1.chunk_id = c1.chunk_id
+  LEFT JOIN files f1 ON c1.file_id = f1.id
+  LEFT JOIN vec_chunks c2 ON e2.chunk_id = c2.chunk_id
+  LEFT JOIN files f2 ON c2.file_id = f2.id
+  WHERE g.relation_type = ?
+  GROUP BY e2.id
+)
+SELECT e3.name AS t_name, e4.name AS x_name
+FROM stage1 s1
+LEFT JOIN entities e3 ON s1.t = e3.id
+JOIN graph_edges g2 ON g2.source_entity_id = e3.id
+JOIN entities e4 ON g2.target_entity_id = e4.id
+LEFT JOIN vec_chunks c3 ON e3.chunk_id = c3.chunk_id
+LEFT JOIN files f3 ON c3.file_id = f3.id
+LEFT JOIN vec_chunks c4 ON e4.chunk_id = c4.chunk_id
+LEFT JOIN files f4 ON c4.file_id = f4.id
+WHERE g2.relation_type = ?
+  AND s1.callers > ?`
+	if result.SQL != expected {
+		t.Errorf("SQL mismatch.\nExpected:\n%s\n\nGot:\n%s", expected, result.SQL)
+	}
+	wantArgs := []interface{}{"calls", "uses", int64(5)}
+	if len(result.Args) != len(wantArgs) {
+		t.Fatalf("Args = %v, want %v", result.Args, wantArgs)
+	}
+	for i, arg := range result.Args {
+		if arg != wantArgs[i] {
+			t.Errorf("Arg[%d] = %v, want %v", i, arg, wantArgs[i])
+		}
+	}
+
+	tests := []struct {
+		name      string
+		query     string
+		wantSQL   []string
+		wantLists []string
+		wantErr   bool
+	}{
+		{
+			name:  "chained stages without match",
+			query: "MATCH (n) WITH n.entity_type AS kind, COUNT(n) AS total WITH COLLECT(kind) AS kinds, SUM(total) AS functions RETURN kinds, functions",
+			wantSQL: []string{
+				"),\nstage2 AS (\n  SELECT json_group_array(s1.kind) FILTER (WHERE s1.kind IS NOT NULL) AS kinds, SUM(s1.total) AS functions\n  FROM stage1 s1\n)\n",
+				"SELECT s2.kinds AS kinds, s2.functions AS functions\nFROM stage2 s2",
+			},
+			wantLists: []string{"kinds"},
+		},
+		{
+			name:  "with distinct and optional match",
+			query: "MATCH (a)-[:calls]->(b) WITH DISTINCT b OPTIONAL MATCH (b)<-[:implements]-(c) RETURN b.name, c.name",
+			wantSQL: []string{
+				"  SELECT DISTINCT e2.id AS b\n",
+				"FROM stage1 s1\nLEFT JOIN entities e3 ON s1.b = e3.id\nLEFT JOIN vec_chunks c3 ON e3.chunk_id = c3.chunk_id\nLEFT JOIN files f3 ON c3.file_id = f3.id\nLEFT JOIN (\n",
+			},
+		},
+		{
+			name:    "unnamed expression",
+			query:   "MATCH (a) WITH a.name RETURN a",
+			wantErr: true,
+		},
+		{
+			name:    "relationship variable",
+			query:   "MATCH (a)-[r:calls]->(b) WITH r RETURN a",
+			wantErr: true,
+		},
+		{
+			name:    "variable not passed on",
+			query:   "MATCH (a)-[:calls]->(b) WITH a RETURN b.name",
+			wantErr: true,
+		},
+		{
+			name:    "value used as a node",
+			query:   "MATCH (a) WITH a.name AS x MATCH (x)-[:calls]->(b) RETURN b",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Transpile(tt.query, TranspileOptions{})
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Expected error, got SQL:\n%s", result.SQL)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Transpile() error = %v", err)
+			}
+			for _, want := range tt.wantSQL {
+				if !strings.Contains(result.SQL, want) {
+					t.Errorf("SQL does not contain %q:\n%s", want, result.SQL)
+				}
+			}
+			if strings.Join(result.Lists, ",") != strings.Join(tt.wantLists, ",") {
+				t.Errorf("Lists = %v, want %v", result.Lists, tt.wantLists)
+			}
+		})
+	}
+}
+
+func TestTranspileUnion(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		wantSQL  []string
+		wantArgs []interface{}
+		wantErr  bool
+	}{
+		{
+			name:  "union all of two relation types",
+			query: "MATCH (a)-[r:calls]->(b) RETURN a.name AS source, type(r) AS rel, b.name AS target UNION ALL MATCH (a)-[r:implements]->(b) RETURN a.name AS source, type(r) AS rel, b.name AS target",
+			wantSQL: []string{
+				"WHERE g.relation_type = ?\nUNION ALL\nSELECT e1.name AS source, g.relation_type AS rel, e2.name AS target\n",
+			},
+			wantArgs: []interface{}{"calls", "implements"},
+		},
+		{
+			name:  "union wraps sorted and paged parts",
+			query: "MATCH (a:FUNCTION) RETURN a.name AS name UNION MATCH (a)-[:calls]->(b) RETURN b.name AS name ORDER BY name LIMIT 1",
+			wantSQL: []string{
+				"WHERE e1.entity_type = ?\nUNION\nSELECT * FROM (\n  SELECT e2.name AS name\n",
+				"\n  ORDER BY name ASC\n  LIMIT 1\n)",
+			},
+			wantArgs: []interface{}{"FUNCTION", "calls"},
+		},
+		{
+			name:  "union wraps parts with CTEs",
+			query: "MATCH (a)-[:calls]->(b) RETURN b.name AS name UNION MATCH (a)-[:uses]-(b) RETURN b.name AS name",
+			wantSQL: []string{
+				"\nUNION\nSELECT * FROM (\n  WITH undirected_edges(",
+			},
+			wantArgs: []interface{}{"calls", "uses"},
+		},
+		{
+			name:    "different column names",
+			query:   "MATCH (a) RETURN a.name UNION MATCH (b) RETURN b.name",
+			wantErr: true,
+		},
+		{
+			name:    "different column kinds",
+			query:   "MATCH (a) RETURN a UNION MATCH (b) WITH b.name AS a RETURN a",
+			wantErr: true,
+		},
+		{
+			name:    "mixed union and union all",
+			query:   "MATCH (a) RETURN a.name AS x UNION MATCH (b) RETURN b.name AS x UNION ALL MATCH (c) RETURN c.name AS x",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Transpile(tt.query, TranspileOptions{})
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Expected error, got SQL:\n%s", result.SQL)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Transpile() error = %v", err)
+			}
+			for _, want := range tt.wantSQL {
+				if !strings.Contains(result.SQL, want) {
+					t.Errorf("SQL does not contain %q:\n%s", want, result.SQL)
+				}
+			}
+			if len(result.Args) != len(tt.wantArgs) {
+				t.Fatalf("Args = %v, want %v", result.Args, tt.wantArgs)
+			}
+			for i, arg := range result.Args {
+				if arg != tt.wantArgs[i] {
+					t.Errorf("Arg[%d] = %v, want %v", i, arg, tt.wantArgs[i])
+				}
+			}
+		})
+	}
+}
+
+func TestTranspilePathVariables(t *testing.T) {
+	result, err := Transpile("MATCH p = (a {name:'main'})-[:calls*1..6]->(b {name:'InsertChunk'}) RETURN nodes(p), length(p)", TranspileOptions{})
+	if err != nil {
+		t.Fatalf("Transpile() error = %v", err)
+	}
+	expected := `WITH RECURSIVE paths(source_id, target_id, depth, edges, path) AS (
+  SELECT g.source_entity_id, g.target_entity_id, 1, CAST(g.rowid AS TEXT), CAST(g.target_entity_id AS TEXT)
+  FROM graph_edges g
+  JOIN entities e1 ON g.source_entity_id = e1.id
+  JOIN entities e2 ON g.target_entity_id = e2.id
+  WHERE 1=1
+    AND e1.name = ?
+    AND g.relation_type = ?
+
+  UNION ALL
+
+  SELECT p.source_id, g.target_entity_id, p.depth + 1, p.edges || ',' || g.rowid, p.path || ',' || g.target_entity_id
+  FROM paths p
+  CROSS JOIN graph_edges g ON p.target_id = g.source_entity_id
+  WHERE g.relation_type = ?
+    AND p.depth < ?
+    AND instr(',' || p.edges || ',', ',' || g.rowid || ',') = 0
+  LIMIT ?
+)
+SELECT DISTINCT (SELECT json_group_array(name) FROM (SELECT n.name FROM json_each('[' || e1.id || ',' || p.path || ']') j JOIN entities n ON n.id = j.value ORDER BY j.key)) AS nodes_p, p.depth AS length_p
+FROM entities e1
+JOIN paths p ON p.source_id = e1.id
+JOIN entities e2 ON p.target_id = e2.id
+LEFT JOIN vec_chunks c1 ON e1.chunk_id = c1.chunk_id
+LEFT JOIN files f1 ON c1.file_id = f1.id
+LEFT JOIN vec_chunks c2 ON e2.chunk_id = c2.chunk_id
+LEFT JOIN files f2 ON c2.file_id = f2.id
+WHERE e1.name = ?
+  AND p.depth >= ?
+  AND (SELECT row_cap(COUNT(*), ?) FROM paths)
+  AND e2.name = ?`
+	if result.SQL != expected {
+		t.Errorf("SQL mismatch.\nExpected:\n%s\n\nGot:\n%s", expected, result.SQL)
+	}
+	if len(result.Lists) != 1 || result.Lists[0] != "nodes_p" {
+		t.Errorf("Lists = %v, want [nodes_p]", result.Lists)
+	}
+
+	tests := []struct {
+		name    string
+		query   string
+		wantSQL []string
+		wantErr bool
+	}{
+		{
+			name:  "fixed hops",
+			query: "MATCH p = (a)-[:implements]->(b) RETURN p, length(p)",
+			wantSQL: []string{
+				"json_each('[' || e1.id || ',' || e2.id || ']')",
+				" AS p, 1 AS length_p\n",
+			},
+		},
+		{
+			name:  "backward hop records nodes in reverse",
+			query: "MATCH p = (a)<-[:calls*2]-(b)-[:uses]->(c) RETURN length(p)",
+			wantSQL: []string{
+				"  SELECT g.source_entity_id, g.target_entity_id, 1, CAST(g.rowid AS TEXT), CAST(g.source_entity_id AS TEXT)\n",
+				"g.source_entity_id || ',' || p.path\n",
+				"SELECT DISTINCT (1 + p.depth) AS length_p\n",
+			},
+		},
+		{
+			name:  "shortest path",
+			query: "MATCH p = shortestPath((a:FUNCTION)-[:calls*]->(b)) RETURN p",
+			wantSQL: []string{
+				"  AND p.path = (SELECT sp.path FROM paths sp WHERE sp.source_id = p.source_id AND sp.target_id = p.target_id AND sp.depth >= ? ORDER BY sp.depth LIMIT 1)",
+			},
+		},
+		{
+			name:  "length in where",
+			query: "MATCH p = (a)-[:calls*1..3]->(b) WHERE length(p) > 1 RETURN a.name",
+			wantSQL: []string{
+				"  AND p.depth > ?",
+			},
+		},
+		{
+			name:    "shortest path over a fixed hop",
+			query:   "MATCH p = shortestPath((a)-[:calls]->(b)) RETURN p",
+			wantErr: true,
+		},
+		{
+			name:    "path property",
+			query:   "MATCH p = (a)-[:calls]->(b) RETURN p.name",
+			wantErr: true,
+		},
+		{
+			name:    "nodes of a node",
+			query:   "MATCH (a)-[:calls]->(b) RETURN nodes(a)",
+			wantErr: true,
+		},
+		{
+			name:    "path reused as node",
+			query:   "MATCH p = (a)-[:calls]->(b), (p)-[:uses]->(c) RETURN c",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Transpile(tt.query, TranspileOptions{})
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Expected error, got SQL:\n%s", result.SQL)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Transpile() error = %v", err)
+			}
+			for _, want := range tt.wantSQL {
+				if !strings.Contains(result.SQL, want) {
+					t.Errorf("SQL does not contain %q:\n%s", want, result.SQL)
+				}
+			}
+		})
+	}
+}
+
+func TestTranspileRecursionBounds(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		rowCap   int
+		wantSQL  []string
+		wantArgs []interface{}
+	}{
+		{
+			name:  "reachability walks every path",
+			query: "MATCH (a)-[:calls*]->(b) RETURN b.name",
+			wantSQL: []string{
+				"\n  UNION ALL\n\n",
+				"    AND instr(',' || p.edges || ',', ',' || g.rowid || ',') = 0\n  LIMIT ?\n)",
+				"\nWHERE p.depth >= ?\n  AND (SELECT row_cap(COUNT(*), ?) FROM paths)",
+			},
+			wantArgs: []interface{}{"calls", "calls", 10, DefaultRowCap + 1, 1, DefaultRowCap},
+		},
+		{
+			name:  "paths never reuse an edge",
+			query: "MATCH p = (a)-[:calls*]->(b) RETURN p",
+			wantSQL: []string{
+				"\n  UNION ALL\n\n",
+				"  SELECT p.source_id, g.target_entity_id, p.depth + 1, p.edges || ',' || g.rowid, p.path || ',' || g.target_entity_id\n",
+				"    AND instr(',' || p.edges || ',', ',' || g.rowid || ',') = 0\n  LIMIT ?\n)",
+			},
+			wantArgs: []interface{}{"calls", "calls", 10, DefaultRowCap + 1, 1, DefaultRowCap},
+		},
+		{
+			name:   "undirected edges are used once in either direction",
+			query:  "MATCH (a)-[*1..3]-(b) RETURN b.name",
+			rowCap: 500,
+			wantSQL: []string{
+				"    AND instr(',' || p.edges || ',', ',' || g.edge_id || ',') = 0\n",
+				"FROM paths)",
+			},
+			wantArgs: []interface{}{3, 501, 1, 500},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Transpile(tt.query, TranspileOptions{RowCap: tt.rowCap})
+			if err != nil {
+				t.Fatalf("Transpile() error = %v", err)
+			}
+			for _, want := range tt.wantSQL {
+				if !strings.Contains(result.SQL, want) {
+					t.Errorf("SQL does not contain %q:\n%s", want, result.SQL)
+				}
+			}
+			if len(result.Args) != len(tt.wantArgs) {
+				t.Fatalf("Args = %v, want %v", result.Args, tt.wantArgs)
+			}
+			for i, arg := range result.Args {
+				if arg != tt.wantArgs[i] {
+					t.Errorf("Arg[%d] = %v, want %v", i, arg, tt.wantArgs[i])
+				}
+			}
+		})
+	}
+}
+
+func TestTranspileMultiHopClauses(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   string
+		wantSQL []string
+		wantErr bool
+	}{
+		{
+			name:  "aggregate, order and limit",
+			query: "MATCH (a)-[:calls*1..3]->(b) RETURN b.name, COUNT(a) AS callers ORDER BY callers DESC LIMIT 10",
+			wantSQL: []string{
+				"WHERE p.depth >= ?\n",
+				"SELECT DISTINCT e2.name AS b_name, COUNT(e1.id) AS callers\n",
+				"\nGROUP BY e2.name\nORDER BY callers DESC\nLIMIT 10",
+			},
+		},
+		{
+			name:  "explicit group by",
+			query: "MATCH (a)-[:calls*1..3]->(b) RETURN b.name, COUNT(*) AS n GROUP BY b.name",
+			wantSQL: []string{
+				"SELECT DISTINCT e2.name AS b_name, COUNT(*) AS n\n",
+				"\nGROUP BY e2.name",
+			},
+		},
+		{
+			name:  "returnable depth",
+			query: "MATCH (a)-[r:calls*1..3]->(b) WHERE length(r) > 1 RETURN a.name, b.name, length(r) AS hops ORDER BY hops",
+			wantSQL: []string{
+				"SELECT DISTINCT e1.name AS a_name, e2.name AS b_name, p.depth AS hops\n",
+				"\n  AND p.depth > ?\nORDER BY hops ASC",
+			},
+		},
+		{
+			name:  "size of a backward relation",
+			query: "MATCH (a)<-[r*]-(b) RETURN size(r)",
+			wantSQL: []string{
+				"SELECT DISTINCT p.depth AS size_r\n",
+			},
+		},
+		{
+			name:    "whole variable-length relation",
+			query:   "MATCH (a)-[r:calls*]->(b) RETURN r",
+			wantErr: true,
+		},
+		{
+			name:    "type of a variable-length relation",
+			query:   "MATCH (a)-[r:calls*]->(b) RETURN type(r)",
+			wantErr: true,
+		},
+		{
+			name:    "property of a variable-length relation",
+			query:   "MATCH (a)-[r:calls*]->(b) WHERE r.weight > 1 RETURN a",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Transpile(tt.query, TranspileOptions{})
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Expected error, got SQL:\n%s", result.SQL)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Transpile() error = %v", err)
+			}
+			for _, want := range tt.wantSQL {
+				if !strings.Contains(result.SQL, want) {
+					t.Errorf("SQL does not contain %q:\n%s", want, result.SQL)
+				}
+			}
+		})
+	}
+}
+
+func TestTranspileExplain(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		wantMode   Mode
+		wantStages []string
+	}{
+		{
+			name:     "plain query runs",
+			query:    "MATCH (a) RETURN a.name",
+			wantMode: ModeRun,
+		},
+		{
+			name:     "explain prefix",
+			query:    "EXPLAIN MATCH (a)-[:calls]->(b) RETURN a.name",
+			wantMode: ModeExplain,
+		},
+		{
+			name:       "profile counts every CTE in order",
+			query:      "PROFILE MATCH (a)-[:calls*1..3]-(b) WITH a, COUNT(b) AS n RETURN a.name, n",
+			wantMode:   ModeProfile,
+			wantStages: []string{"undirected_edges", "paths", "stage1"},
+		},
+		{
+			name:       "profile names stages by union part",
+			query:      "PROFILE MATCH (a)-[:calls*]->(b) RETURN b.name AS name UNION MATCH (a)-[:uses*]->(b) RETURN b.name AS name",
+			wantMode:   ModeProfile,
+			wantStages: []string{"paths (part 1)", "paths (part 2)"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Transpile(tt.query, TranspileOptions{})
+			if err != nil {
+				t.Fatalf("Transpile() error = %v", err)
+			}
+			if result.Mode != tt.wantMode {
+				t.Errorf("Mode = %q, want %q", result.Mode, tt.wantMode)
+			}
+			if strings.Contains(result.SQL, "EXPLAIN") || strings.Contains(result.SQL, "PROFILE") {
+				t.Errorf("SQL contains the query prefix:\n%s", result.SQL)
+			}
+			if tt.wantStages == nil {
+				return
+			}
+			var names []string
+			for _, stage := range result.Stages {
+				names = append(names, stage.Name)
+			}
+			if strings.Join(names, ", ") != strings.Join(tt.wantStages, ", ") {
+				t.Errorf("Stages = %v, want %v", names, tt.wantStages)
+			}
+
+			// Each stage counts its CTE with the args of the CTEs up to it
+			for _, stage := range result.Stages {
+				if !strings.HasPrefix(stage.SQL, "WITH RECURSIVE ") || !strings.Contains(stage.SQL, "\nSELECT COUNT(*) FROM ") {
+					t.Errorf("Stage %s SQL =\n%s", stage.Name, stage.SQL)
+				}
+				if strings.Count(stage.SQL, "?") != len(stage.Args) {
+					t.Errorf("Stage %s has %d placeholders but %d args", stage.Name, strings.Count(stage.SQL, "?"), len(stage.Args))
+				}
+			}
+		})
+	}
+}
+
+func TestTranspileSyntaxErrors(t *testing.T) {
+	tests := []struct {
+		name           string
+		query          string
+		wantLine       int
+		wantColumn     int
+		wantFound      string
+		wantExpected   string
+		wantSuggestion string
+		wantCaret      string
+	}{
+		{
+			name:           "lowercase label",
+			query:          "MATCH (f:function) RETURN f.name",
+			wantLine:       1,
+			wantColumn:     10,
+			wantFound:      "function",
+			wantExpected:   "an uppercase name",
+			wantSuggestion: "write :FUNCTION",
+			wantCaret:      "\n           ^^^^^^^^",
+		},
+		{
+			name:           "lowercase keyword on a later line",
+			query:          "MATCH (a)\nWHERE a.name = 'x'\nreturn a",
+			wantLine:       3,
+			wantColumn:     1,
+			wantFound:      "return",
+			wantExpected:   "RETURN",
+			wantSuggestion: "write RETURN",
+			wantCaret:      "\n  return a\n  ^^^^^^",
+		},
+		{
+			name:           "unsupported clause",
+			query:          "MATCH (a) SET a.name = 'x' RETURN a",
+			wantLine:       1,
+			wantColumn:     11,
+			wantFound:      "SET",
+			wantExpected:   "WHERE",
+			wantSuggestion: "read-only",
+		},
+		{
+			name:           "missing arrow head",
+			query:          "MATCH (a)-[:calls](b) RETURN a",
+			wantLine:       1,
+			wantColumn:     19,
+			wantFound:      "(",
+			wantExpected:   `"-"`,
+			wantSuggestion: "close the relation with -> or -",
+		},
+		{
+			name:         "end of query",
+			query:        "MATCH (a) RETURN",
+			wantLine:     1,
+			wantColumn:   17,
+			wantFound:    "",
+			wantExpected: "a name",
+		},
+		{
+			name:           "unterminated string",
+			query:          "MATCH (a) WHERE a.name = 'x\nRETURN a",
+			wantLine:       1,
+			wantColumn:     26,
+			wantFound:      "'x",
+			wantExpected:   "a quoted string",
+			wantSuggestion: "matching '",
+			wantCaret:      "\n                           ^^",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Transpile(tt.query, TranspileOptions{})
+			syntaxErr, ok := err.(*SyntaxError)
+			if !ok {
+				t.Fatalf("Expected *SyntaxError, got %T: %v", err, err)
+			}
+			if syntaxErr.Line != tt.wantLine || syntaxErr.Column != tt.wantColumn {
+				t.Errorf("Position = %d:%d, want %d:%d", syntaxErr.Line, syntaxErr.Column, tt.wantLine, tt.wantColumn)
+			}
+			if syntaxErr.Found != tt.wantFound {
+				t.Errorf("Found = %q, want %q", syntaxErr.Found, tt.wantFound)
+			}
+			if !strings.Contains(strings.Join(syntaxErr.Expected, "|"), tt.wantExpected) {
+				t.Errorf("Expected = %v, want it to include %s", syntaxErr.Expected, tt.wantExpected)
+			}
+			if tt.wantSuggestion == "" && syntaxErr.Suggestion != "" {
+				t.Errorf("Unexpected suggestion %q", syntaxErr.Suggestion)
+			}
+			if !strings.Contains(syntaxErr.Suggestion, tt.wantSuggestion) {
+				t.Errorf("Suggestion = %q, want it to contain %q", syntaxErr.Suggestion, tt.wantSuggestion)
+			}
+			if !strings.Contains(syntaxErr.Detail(), tt.wantCaret) {
+				t.Errorf("Detail() =\n%s\nwant it to contain %q", syntaxErr.Detail(), tt.wantCaret)
+			}
+		})
+	}
+}
+
+func TestTranspileSimilarity(t *testing.T) {
+	embed := func(text string) ([]float32, error) {
+		return []float32{1, float32(len(text))}, nil
+	}
+	tests := []struct {
+		name     string
+		query    string
+		embed    func(string) ([]float32, error)
+		wantSQL  []string
+		wantArgs int // blob args
+		wantErr  bool
+	}{
+		{
+			name:  "similar in WHERE",
+			query: `MATCH (f:FUNCTION)-[:calls]->(t) WHERE similar(f, "retry with backoff") < 0.4 RETURN f.name, t.name`,
+			embed: embed,
+			wantSQL: []string{
+				"WITH similar1(embedding) AS (SELECT ?)\nSELECT ",
+				"AND (SELECT vec_distance_cosine(c1.embedding, embedding) FROM similar1 WHERE c1.embedding IS NOT NULL) < ?",
+			},
+			wantArgs: 1,
+		},
+		{
+			name:  "same text is embedded once",
+			query: `MATCH (f) WHERE similar(f, "retry") < 0.4 RETURN f.name, similar(f, "retry") AS distance ORDER BY distance`,
+			embed: embed,
+			wantSQL: []string{
+				"FROM similar1 WHERE c1.embedding IS NOT NULL) AS distance",
+			},
+			wantArgs: 1,
+		},
+		{
+			name:  "search seeds the match",
+			query: `CALL chainsaw.search("retry", 20) YIELD node AS f, distance MATCH (f)-[:calls]->(t) RETURN f.name, t.name, distance`,
+			embed: embed,
+			wantSQL: []string{
+				"WITH search(chunk_id, distance) AS (\n  SELECT chunk_id, distance FROM vec_chunks\n  WHERE embedding MATCH ? AND k = ?\n)",
+				"SELECT e1.name AS f_name, e2.name AS t_name, k.distance AS distance\nFROM search k\nJOIN entities e1 ON k.chunk_id = e1.chunk_id\n",
+			},
+			wantArgs: 1,
+		},
+		{
+			name:    "no embedding model",
+			query:   `MATCH (f) WHERE similar(f, "retry") < 0.4 RETURN f.name`,
+			wantErr: true,
+		},
+		{
+			name:    "similar needs a text",
+			query:   `MATCH (f) RETURN similar(f, 1) AS d`,
+			embed:   embed,
+			wantErr: true,
+		},
+		{
+			name:    "unknown procedure",
+			query:   `CALL chainsaw.find("retry") YIELD node RETURN node.name`,
+			embed:   embed,
+			wantErr: true,
+		},
+		{
+			name:    "unknown yield column",
+			query:   `CALL chainsaw.search("retry") YIELD score RETURN score`,
+			embed:   embed,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Transpile(tt.query, TranspileOptions{Embed: tt.embed})
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Expected error, got SQL:\n%s", result.SQL)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Transpile() error = %v", err)
+			}
+			for _, want := range tt.wantSQL {
+				if !strings.Contains(result.SQL, want) {
+					t.Errorf("SQL missing %q:\n%s", want, result.SQL)
+				}
+			}
+			blobs := 0
+			for _, arg := range result.Args {
+				if blob, ok := arg.([]byte); ok {
+					blobs++
+					if len(blob) != 8 {
+						t.Errorf("Embedding blob has %d bytes, want 8", len(blob))
+					}
+				}
+			}
+			if blobs != tt.wantArgs {
+				t.Errorf("Got %d embedding args, want %d", blobs, tt.wantArgs)
+			}
+		})
+	}
+}
+
+func TestTranspileProcedures(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		cwd      string
+		wantSQL  []string
+		wantArgs []interface{}
+		wantErr  bool
+	}{
+		{
+			name:  "labels",
+			query: `CALL db.labels()`,
+			wantSQL: []string{
+				"WITH labels(label, count) AS (\n  SELECT e.entity_type, COUNT(*)\n  FROM entities e\n  GROUP BY e.entity_type\n)",
+				"SELECT labels.label AS label, labels.count AS count\nFROM labels",
+				"ORDER BY count DESC",
+			},
+		},
+		{
+			name:  "labels in the current directory",
+			query: `CALL db.labels()`,
+			cwd:   "/repo",
+			wantSQL: []string{
+				"JOIN files f ON c.file_id = f.id\n  WHERE f.path LIKE ?",
+			},
+			wantArgs: []interface{}{"/repo/%"},
+		},
+		{
+			name:    "relationship types",
+			query:   `CALL db.relationshipTypes()`,
+			wantSQL: []string{"SELECT g.relation_type, COUNT(*)\n  FROM graph_edges g\n  GROUP BY g.relation_type"},
+		},
+		{
+			name:  "schema",
+			query: `CALL db.schema()`,
+			wantSQL: []string{
+				"MIN(es.name || ' -[' || g.relation_type || ']-> ' || et.name)",
+				"GROUP BY es.entity_type, g.relation_type, et.entity_type",
+			},
+		},
+		{
+			name:    "yield with alias",
+			query:   `CALL db.labels() YIELD label AS type, count RETURN type ORDER BY count DESC LIMIT 3`,
+			wantSQL: []string{"SELECT labels.label AS type\nFROM labels"},
+		},
+		{
+			name:     "yield with where",
+			query:    `CALL db.schema() YIELD source, relationship, target WHERE relationship = "calls" RETURN source, target`,
+			wantSQL:  []string{"schema.relationship = ?"},
+			wantArgs: []interface{}{"calls"},
+		},
+		{
+			name:    "unknown yield column",
+			query:   `CALL db.labels() YIELD name RETURN name`,
+			wantErr: true,
+		},
+		{
+			name:    "unknown procedure",
+			query:   `CALL db.indexes()`,
+			wantErr: true,
+		},
+		{
+			name:    "arguments",
+			query:   `CALL db.labels("FUNCTION")`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Transpile(tt.query, TranspileOptions{CWD: tt.cwd})
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Expected error, got SQL:\n%s", result.SQL)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Transpile() error = %v", err)
+			}
+			for _, want := range tt.wantSQL {
+				if !strings.Contains(result.SQL, want) {
+					t.Errorf("SQL missing %q:\n%s", want, result.SQL)
+				}
+			}
+			if tt.wantArgs != nil && fmt.Sprint(result.Args) != fmt.Sprint(tt.wantArgs) {
+				t.Errorf("Args = %v, want %v", result.Args, tt.wantArgs)
+			}
+		})
+	}
+}
@@ -0,0 +1,266 @@
+package cypher
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/wouteroostervld/chainsaw/pkg/cypher/ast"
+)
+
+// propertyColumn maps a node or relationship property to its SQL expression.
+// Virtual properties (snippet, file, lines) come from the chunk and file
+// joined for the alias (e1 -> c1/f1, e2 -> c2/f2, g2 -> cg2/fg2).
+// Relationships also expose type and chunk (the snippet the edge was
+// observed in).
+func propertyColumn(tableAlias, property string) string {
+	if isRelationshipAlias(tableAlias) {
+		switch property {
+		case "type":
+			return tableAlias + ".relation_type"
+		case "chunk":
+			property = "snippet"
+		}
+	}
+	n := chunkSuffix(tableAlias)
+	switch property {
+	case "snippet":
+		return fmt.Sprintf("c%s.content_snippet", n)
+	case "file":
+		return fmt.Sprintf("f%s.path", n)
+	case "lines":
+		return fmt.Sprintf("(c%s.start_line || '-' || c%s.end_line)", n, n)
+	default:
+		return fmt.Sprintf("%s.%s", tableAlias, property)
+	}
+}
+
+// functionColumn maps a scalar function call on a variable to SQL.
+// nodes(p) lists the names of the entities on a path as a JSON array;
+// length(r) (or size(r)) of a variable-length relation is its hop count;
+// similar(n, text) is the only function taking a second argument.
+func (pj *patternJoins) functionColumn(name, variable string, arg ast.Expression) (string, error) {
+	if name == "similar" {
+		return pj.similarColumn(variable, arg)
+	}
+	if arg != nil {
+		return "", fmt.Errorf("%s() takes a single variable", name)
+	}
+	if path, ok := pj.paths[variable]; ok {
+		switch name {
+		case "nodes":
+			return fmt.Sprintf("(SELECT json_group_array(name) FROM (SELECT n.name FROM json_each(%s) j JOIN entities n ON n.id = j.value ORDER BY j.key))", path.nodes), nil
+		case "length":
+			return path.length, nil
+		default:
+			return "", fmt.Errorf("%s() does not take a path; use nodes(%s) or length(%s)", name, variable, variable)
+		}
+	}
+	if alias, ok := pj.depths[variable]; ok {
+		if name != "length" && name != "size" {
+			return "", fmt.Errorf("%s() is not available on variable-length relation %s; use length(%s)", name, variable, variable)
+		}
+		return alias + ".depth", nil
+	}
+	tableAlias, ok := pj.vars[variable]
+	if !ok {
+		return "", fmt.Errorf("unknown variable in %s(): %s", name, variable)
+	}
+	switch name {
+	case "nodes", "length", "size":
+		return "", fmt.Errorf("%s() requires a path variable, e.g. MATCH p = (a)-[:calls*]->(b) RETURN %s(p)", name, name)
+	case "type":
+		if !isRelationshipAlias(tableAlias) {
+			return "", fmt.Errorf("type() requires a relationship variable, got %s", variable)
+		}
+		return tableAlias + ".relation_type", nil
+	default:
+		return "", fmt.Errorf("unknown function: %s", name)
+	}
+}
+
+// compileExpression translates a WHERE expression into a SQL condition.
+// Variables are resolved through the bound pattern variables and the values
+// passed on by WITH, $parameters through the transpile options, and every
+// literal or parameter value becomes a bound ? parameter.
+func (pj *patternJoins) compileExpression(expr ast.Expression) (string, []interface{}, error) {
+	aliases, params := pj.vars, pj.opts.Params
+	switch e := expr.(type) {
+	case *ast.BinaryExpr:
+		left, leftArgs, err := pj.compileExpression(e.Left)
+		if err != nil {
+			return "", nil, err
+		}
+		right, rightArgs, err := pj.compileExpression(e.Right)
+		if err != nil {
+			return "", nil, err
+		}
+		return fmt.Sprintf("(%s %s %s)", left, e.Op, right), append(leftArgs, rightArgs...), nil
+
+	case *ast.NotExpr:
+		inner, args, err := pj.compileExpression(e.Expr)
+		if err != nil {
+			return "", nil, err
+		}
+		return fmt.Sprintf("NOT (%s)", inner), args, nil
+
+	case *ast.Comparison:
+		left, leftArgs, err := pj.compileExpression(e.Left)
+		if err != nil {
+			return "", nil, err
+		}
+		if e.Op == "IN" {
+			right := e.Right
+			if param, ok := right.(*ast.Parameter); ok {
+				if right, err = listParameter(param, params); err != nil {
+					return "", nil, err
+				}
+			}
+			list, ok := right.(*ast.ListLiteral)
+			if !ok {
+				return "", nil, fmt.Errorf("IN requires a list literal")
+			}
+			if len(list.Items) == 0 {
+				// Nothing is a member of the empty list
+				return "0", nil, nil
+			}
+			args := leftArgs
+			placeholders := []string{}
+			for _, item := range list.Items {
+				itemSQL, itemArgs, err := pj.compileExpression(item)
+				if err != nil {
+					return "", nil, err
+				}
+				placeholders = append(placeholders, itemSQL)
+				args = append(args, itemArgs...)
+			}
+			return fmt.Sprintf("%s IN (%s)", left, strings.Join(placeholders, ", ")), args, nil
+		}
+		right, rightArgs, err := pj.compileExpression(e.Right)
+		if err != nil {
+			return "", nil, err
+		}
+		switch e.Op {
+		case "STARTS WITH":
+			return fmt.Sprintf("instr(%s, %s) = 1", left, right), append(leftArgs, rightArgs...), nil
+		case "CONTAINS":
+			return fmt.Sprintf("instr(%s, %s) > 0", left, right), append(leftArgs, rightArgs...), nil
+		case "ENDS WITH":
+			// Both operands appear twice, and so do their args
+			args := append(append(append(append([]interface{}{}, leftArgs...), leftArgs...), rightArgs...), rightArgs...)
+			return fmt.Sprintf("substr(%s, length(%s) - length(%s) + 1) = %s", left, left, right, right), args, nil
+		case "=~":
+			// regexp() is registered on the connection by db.Open
+			return fmt.Sprintf("%s REGEXP %s", left, right), append(leftArgs, rightArgs...), nil
+		}
+		return fmt.Sprintf("%s %s %s", left, e.Op, right), append(leftArgs, rightArgs...), nil
+
+	case *ast.IsNullExpr:
+		inner, args, err := pj.compileExpression(e.Expr)
+		if err != nil {
+			return "", nil, err
+		}
+		if e.Negated {
+			return inner + " IS NOT NULL", args, nil
+		}
+		return inner + " IS NULL", args, nil
+
+	case *ast.PropertyRef:
+		if _, ok := pj.depths[e.Variable]; ok {
+			return "", nil, fmt.Errorf("variable-length relation %s has no properties; use length(%s)", e.Variable, e.Variable)
+		}
+		if _, ok := pj.paths[e.Variable]; ok {
+			return "", nil, fmt.Errorf("path variable %s has no properties; use nodes(%s) or length(%s)", e.Variable, e.Variable, e.Variable)
+		}
+		if _, ok := pj.scalars[e.Variable]; ok {
+			return "", nil, fmt.Errorf("%s is not a node or relationship, so it has no property %s", e.Variable, e.Property)
+		}
+		tableAlias, ok := aliases[e.Variable]
+		if !ok {
+			return "", nil, fmt.Errorf("unknown variable in WHERE: %s", e.Variable)
+		}
+		return propertyColumn(tableAlias, e.Property), nil, nil
+
+	case *ast.VariableRef:
+		if column, ok := pj.scalars[e.Variable]; ok {
+			return column, nil, nil
+		}
+		if _, ok := pj.paths[e.Variable]; ok {
+			return "", nil, fmt.Errorf("path variable %s can only be used through nodes(%s) or length(%s)", e.Variable, e.Variable, e.Variable)
+		}
+		if _, ok := pj.depths[e.Variable]; ok {
+			return "", nil, fmt.Errorf("variable-length relation %s can only be used through length(%s)", e.Variable, e.Variable)
+		}
+		tableAlias, ok := aliases[e.Variable]
+		if !ok {
+			return "", nil, fmt.Errorf("unknown variable in WHERE: %s", e.Variable)
+		}
+		if isRelationshipAlias(tableAlias) {
+			return "", nil, fmt.Errorf("relationship variable %s can only be compared through its properties", e.Variable)
+		}
+		return tableAlias + ".id", nil, nil
+
+	case *ast.FunctionCall:
+		column, err := pj.functionColumn(e.Name, e.Variable, e.Argument)
+		if err != nil {
+			return "", nil, err
+		}
+		return column, nil, nil
+
+	case *ast.Literal:
+		if e.Value == nil {
+			return "NULL", nil, nil
+		}
+		return "?", []interface{}{e.Value}, nil
+
+	case *ast.ExistsExpr:
+		return pj.compileExists(e)
+
+	case *ast.Parameter:
+		value, err := parameterValue(e, params)
+		if err != nil {
+			return "", nil, err
+		}
+		if _, ok := value.([]interface{}); ok {
+			return "", nil, fmt.Errorf("list parameter $%s is only supported on the right of IN", e.Name)
+		}
+		if value == nil {
+			return "NULL", nil, nil
+		}
+		return "?", []interface{}{value}, nil
+
+	case *ast.ListLiteral:
+		return "", nil, fmt.Errorf("list literals are only supported on the right of IN")
+
+	default:
+		return "", nil, fmt.Errorf("unsupported expression in WHERE: %T", expr)
+	}
+}
+
+// parameterValue looks up the value bound to a $parameter
+func parameterValue(param *ast.Parameter, params map[string]interface{}) (interface{}, error) {
+	value, ok := params[param.Name]
+	if !ok {
+		return nil, fmt.Errorf("missing value for parameter $%s", param.Name)
+	}
+	if _, ok := value.(map[string]interface{}); ok {
+		return nil, fmt.Errorf("parameter $%s: map values are not supported", param.Name)
+	}
+	return value, nil
+}
+
+// listParameter turns a list-valued $parameter into a list literal for IN
+func listParameter(param *ast.Parameter, params map[string]interface{}) (ast.Expression, error) {
+	value, err := parameterValue(param, params)
+	if err != nil {
+		return nil, err
+	}
+	values, ok := value.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("IN requires a list, but parameter $%s is %v", param.Name, value)
+	}
+	list := &ast.ListLiteral{}
+	for _, v := range values {
+		list.Items = append(list.Items, &ast.Literal{Value: v})
+	}
+	return list, nil
+}
@@ -0,0 +1,203 @@
+package cypher
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/wouteroostervld/chainsaw/pkg/cypher/ast"
+)
+
+// pipeline collects the CTEs of a query. Every WITH stage becomes a CTE
+// stage<n> that the next stage reads as s<n>; the recursive path CTEs of all
+// stages are hoisted in front of them. Entity aliases and hop numbers run on
+// across stages so every CTE name stays unique.
+type pipeline struct {
+	opts       TranspileOptions
+	call       *ast.CallClause // CALL seeding the first stage, if any
+	paths      []cte           // path and similarity CTEs of all stages
+	stages     []cte           // stage<n> CTEs
+	embeddings map[string]string
+	undirected bool
+	bound      int
+	hops       int
+	prev       *stageOutput     // output of the previous WITH, if any
+	filter     *ast.WhereClause // WITH ... WHERE, applied by the next stage
+}
+
+// stageOutput describes the columns a WITH passes on
+type stageOutput struct {
+	name    string // CTE name, e.g. stage1
+	alias   string // alias it is read as, e.g. s1
+	columns []string
+	nodes   map[string]bool // columns holding entity ids
+	lists   map[string]bool // columns holding JSON arrays (COLLECT)
+}
+
+// matchStage joins the MATCH clauses of one stage onto the output of the
+// previous stage and compiles their WHERE predicates, preceded by the WHERE
+// of the previous WITH
+func (p *pipeline) matchStage(matches []*ast.MatchClause) (*patternJoins, []string, []interface{}, error) {
+	pj := newPatternJoins(p.opts)
+	pj.bound, pj.hops = p.bound, p.hops
+	pj.embeddings = p.embeddings
+	if p.prev != nil {
+		pj.importStage(p.prev)
+	}
+	if p.call != nil {
+		if err := pj.addCall(p.call); err != nil {
+			return nil, nil, nil, err
+		}
+		p.call = nil
+	}
+
+	// Equalities every row must satisfy seed the walks of all patterns
+	for _, match := range matches {
+		if !match.Optional {
+			pj.addWhereSeeds(match.Where)
+		}
+	}
+	for _, match := range matches {
+		if match.Optional {
+			if err := pj.addOptional(match); err != nil {
+				return nil, nil, nil, err
+			}
+			continue
+		}
+		for _, pattern := range match.Patterns {
+			if err := pj.addPattern(pattern); err != nil {
+				return nil, nil, nil, err
+			}
+		}
+	}
+
+	// User-supplied WHERE predicates, one per MATCH clause (OPTIONAL MATCH
+	// predicates are already part of their join). Compiled before rendering
+	// as pattern predicates may add CTEs.
+	var whereConds []string
+	var whereArgs []interface{}
+	wheres := []*ast.WhereClause{p.filter}
+	for _, match := range matches {
+		if !match.Optional {
+			wheres = append(wheres, match.Where)
+		}
+	}
+	for _, where := range wheres {
+		if where == nil {
+			continue
+		}
+		cond, condArgs, err := pj.compileExpression(where.Expr)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		whereConds = append(whereConds, cond)
+		whereArgs = append(whereArgs, condArgs...)
+	}
+	return pj, whereConds, whereArgs, nil
+}
+
+// collect hoists the CTEs of a compiled stage, including those its
+// projection added, and carries its alias counters on to the next stage
+func (p *pipeline) collect(pj *patternJoins) {
+	p.paths = append(p.paths, pj.ctes...)
+	p.undirected = p.undirected || pj.undirected
+	p.bound, p.hops = pj.bound, pj.hops
+}
+
+// addStage compiles a MATCH ... WITH stage into the next stage<n> CTE
+func (p *pipeline) addStage(stage *ast.WithStage) error {
+	pj, whereConds, whereArgs, err := p.matchStage(stage.Matches)
+	if err != nil {
+		return err
+	}
+	proj, err := pj.project(stage.With.Items, true)
+	if err != nil {
+		return err
+	}
+	p.collect(pj)
+
+	var sql strings.Builder
+	args := pj.writeSelect(&sql, proj, pj.distinct || stage.With.Distinct, whereConds, whereArgs)
+	if proj.hasAggregate && len(proj.groupKeys) > 0 {
+		sql.WriteString("\nGROUP BY ")
+		sql.WriteString(strings.Join(proj.groupKeys, ", "))
+	}
+
+	n := len(p.stages) + 1
+	out := &stageOutput{
+		name:    fmt.Sprintf("stage%d", n),
+		alias:   fmt.Sprintf("s%d", n),
+		columns: proj.columns,
+		nodes:   proj.nodes,
+		lists:   map[string]bool{},
+	}
+	for _, name := range proj.lists {
+		out.lists[name] = true
+	}
+	p.stages = append(p.stages, cte{
+		name: out.name,
+		sql:  fmt.Sprintf("%s AS (\n  %s\n)", out.name, strings.ReplaceAll(sql.String(), "\n", "\n  ")),
+		args: args,
+	})
+	p.prev = out
+	p.filter = stage.With.Where
+	return nil
+}
+
+// importStage starts the FROM clause with the previous stage. Nodes passed
+// on are joined back to their entities, so later patterns and properties
+// use them like any bound node; other columns become scalar variables.
+func (pj *patternJoins) importStage(s *stageOutput) {
+	pj.joins = append(pj.joins, sqlJoin{op: "FROM", table: s.name + " " + s.alias})
+	for _, column := range s.columns {
+		ref := s.alias + "." + column
+		if !s.nodes[column] {
+			pj.scalars[column] = ref
+			if s.lists[column] {
+				pj.listVars[column] = true
+			}
+			continue
+		}
+		// LEFT JOIN keeps rows whose node came from an OPTIONAL MATCH
+		pj.bound++
+		alias := fmt.Sprintf("e%d", pj.bound)
+		pj.entities = append(pj.entities, alias)
+		pj.vars[column] = alias
+		pj.joins = append(pj.joins, sqlJoin{
+			op:    "LEFT JOIN",
+			table: "entities " + alias,
+			on:    []joinCond{{fmt.Sprintf("%s = %s.id", ref, alias), alias}},
+		})
+	}
+}
+
+// withName is the column name of a WITH item. Only plain variables may go
+// unnamed; expressions and aggregates need AS, as in Cypher.
+func withName(item ast.ReturnItem) (string, error) {
+	if item.Alias != "" {
+		return item.Alias, nil
+	}
+	var expr string
+	switch {
+	case item.Aggregate != nil:
+		arg := "*"
+		if item.Aggregate.Variable != "" {
+			arg = item.Aggregate.Variable
+			if item.Aggregate.Property != "" {
+				arg += "." + item.Aggregate.Property
+			}
+		}
+		if item.Aggregate.Distinct {
+			arg = "DISTINCT " + arg
+		}
+		expr = fmt.Sprintf("%s(%s)", item.Aggregate.Function, arg)
+	case item.Function != "" && item.Argument != nil:
+		expr = fmt.Sprintf("%s(%s, ...)", item.Function, item.Variable)
+	case item.Function != "":
+		expr = fmt.Sprintf("%s(%s)", item.Function, item.Variable)
+	case item.Property != "":
+		expr = item.Variable + "." + item.Property
+	default:
+		return item.Variable, nil
+	}
+	return "", fmt.Errorf("WITH %s must be named, e.g. WITH %s AS value", expr, expr)
+}
@@ -1,6 +1,7 @@
 package db
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"strings"
@@ -140,6 +141,12 @@ type RelatedChunk struct {
 // SearchSimilar finds chunks similar to the query embedding
 // Uses cosine distance for vector similarity
 func (db *DB) SearchSimilar(queryEmbedding []float32, limit int, pathFilter string) ([]*SearchResult, error) {
+	return db.SearchSimilarContext(context.Background(), queryEmbedding, limit, pathFilter)
+}
+
+// SearchSimilarContext is SearchSimilar bound to a context, which interrupts
+// the search when cancelled
+func (db *DB) SearchSimilarContext(ctx context.Context, queryEmbedding []float32, limit int, pathFilter string) ([]*SearchResult, error) {
 	if len(queryEmbedding) != db.embeddingDim {
 		return nil, fmt.Errorf("query embedding dimension mismatch: expected %d, got %d", db.embeddingDim, len(queryEmbedding))
 	}
@@ -175,7 +182,7 @@ func (db *DB) SearchSimilar(queryEmbedding []float32, limit int, pathFilter stri
 
 	query += "\nORDER BY distance"
 
-	rows, err := db.conn.Query(query, args...)
+	rows, err := db.conn.QueryContext(ctx, query, args...)
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to search similar chunks: %w", err)
@@ -212,14 +219,22 @@ func (db *DB) SearchSimilar(queryEmbedding []float32, limit int, pathFilter stri
 
 // SearchWithRelations is like SearchSimilar but also includes graph-connected chunks
 func (db *DB) SearchWithRelations(queryEmbedding []float32, limit int, maxDepth int, pathFilter string) ([]*SearchResult, error) {
+	return db.SearchWithRelationsContext(context.Background(), queryEmbedding, limit, maxDepth, pathFilter)
+}
+
+// SearchWithRelationsContext is SearchWithRelations bound to a context
+func (db *DB) SearchWithRelationsContext(ctx context.Context, queryEmbedding []float32, limit int, maxDepth int, pathFilter string) ([]*SearchResult, error) {
 	// First get vector search results WITH path filtering
-	results, err := db.SearchSimilar(queryEmbedding, limit, pathFilter)
+	results, err := db.SearchSimilarContext(ctx, queryEmbedding, limit, pathFilter)
 	if err != nil {
 		return nil, err
 	}
 
 	// For each result, get related chunks via graph
 	for _, result := range results {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
 		neighbors, err := db.GetNeighbors(GetNeighborsOptions{
 			ChunkID:   result.Chunk.ChunkID,
 			MaxDepth:  maxDepth,
@@ -1,10 +1,12 @@
 package db
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	sqlite_vec "github.com/asg017/sqlite-vec-go-bindings/cgo"
@@ -55,7 +57,7 @@ func Open(cfg Config) (*DB, error) {
 	// Open database connection with query parameters
 	// _journal_mode=WAL will be set via PRAGMA after opening
 	dsn := fmt.Sprintf("file:%s?_foreign_keys=on", cfg.Path)
-	conn, err := sql.Open("sqlite3", dsn)
+	conn, err := sql.Open(driverName, dsn)
 	if err != nil {
 		return nil, fmt.Errorf("failed to open database: %w", err)
 	}
@@ -329,5 +331,49 @@ func (db *DB) HealthCheck() error {
 
 // RawQuery executes a raw SQL query with args and returns the result rows
 func (d *DB) RawQuery(sql string, args ...interface{}) (*sql.Rows, error) {
-	return d.conn.Query(sql, args...)
+	return d.RawQueryContext(context.Background(), sql, args...)
+}
+
+// RawQueryContext is RawQuery bound to a context. Cancelling ctx or reaching
+// its deadline interrupts SQLite, so the query or the iteration of its rows
+// stops with an error and the connection can be reused.
+func (d *DB) RawQueryContext(ctx context.Context, sql string, args ...interface{}) (*sql.Rows, error) {
+	return d.conn.QueryContext(ctx, sql, args...)
+}
+
+// QueryPlan returns SQLite's EXPLAIN QUERY PLAN for a query as an indented
+// tree, one step per line
+func (d *DB) QueryPlan(query string, args ...interface{}) (string, error) {
+	return d.QueryPlanContext(context.Background(), query, args...)
+}
+
+// QueryPlanContext is QueryPlan bound to a context
+func (d *DB) QueryPlanContext(ctx context.Context, query string, args ...interface{}) (string, error) {
+	rows, err := d.conn.QueryContext(ctx, "EXPLAIN QUERY PLAN "+query, args...)
+	if err != nil {
+		return "", fmt.Errorf("failed to explain query: %w", err)
+	}
+	defer rows.Close()
+
+	var plan strings.Builder
+	depths := map[int]int{}
+	for rows.Next() {
+		var id, parent, notUsed int
+		var detail string
+		if err := rows.Scan(&id, &parent, &notUsed, &detail); err != nil {
+			return "", fmt.Errorf("failed to scan query plan: %w", err)
+		}
+		depth := 0
+		if parent != 0 {
+			depth = depths[parent] + 1
+		}
+		depths[id] = depth
+		plan.WriteString(strings.Repeat("  ", depth))
+		plan.WriteString(detail)
+		plan.WriteString("\n")
+	}
+	if err := rows.Err(); err != nil {
+		return "", fmt.Errorf("failed to read query plan: %w", err)
+	}
+	return strings.TrimSuffix(plan.String(), "\n"), nil
 }
@@ -1,9 +1,14 @@
 package db
 
 import (
+	"context"
+	"database/sql"
 	"os"
 	"path/filepath"
+	"regexp"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestOpen_NewDatabase(t *testing.T) {
@@ -304,3 +309,217 @@ func TestClose(t *testing.T) {
 		t.Errorf("Second close failed: %v", err)
 	}
 }
+
+func TestRegexpFunction(t *testing.T) {
+	tmpDir := t.TempDir()
+	dbPath := filepath.Join(tmpDir, "test.db")
+
+	cfg := Config{
+		Path:         dbPath,
+		EmbeddingDim: 384,
+		SkipVecTable: true,
+	}
+
+	db, err := Open(cfg)
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	defer db.Close()
+
+	tests := []struct {
+		value   interface{}
+		pattern string
+		want    sql.NullBool
+	}{
+		{"/src/db_test.go", `.*_test\.go`, sql.NullBool{Bool: true, Valid: true}},
+		{"/src/db_test.go.bak", `.*_test\.go`, sql.NullBool{Bool: false, Valid: true}},
+		{"HandleQuery", "Handle", sql.NullBool{Bool: false, Valid: true}}, // whole value must match
+		{"HandleQuery", "Handle.*", sql.NullBool{Bool: true, Valid: true}},
+		{nil, ".*", sql.NullBool{}},
+	}
+
+	for _, tt := range tests {
+		var got sql.NullBool
+		row := db.conn.QueryRow("SELECT ? REGEXP ?", tt.value, tt.pattern)
+		if err := row.Scan(&got); err != nil {
+			t.Fatalf("REGEXP %q: %v", tt.pattern, err)
+		}
+		if got != tt.want {
+			t.Errorf("%v REGEXP %q = %v, want %v", tt.value, tt.pattern, got, tt.want)
+		}
+	}
+
+	if _, err := db.conn.Exec("SELECT 'x' REGEXP '('"); err == nil {
+		t.Error("Expected error for invalid regular expression")
+	}
+}
+
+func TestPatternCache(t *testing.T) {
+	cache := newPatternCache(2)
+	a, b, c := regexp.MustCompile("a"), regexp.MustCompile("b"), regexp.MustCompile("c")
+	cache.put("a", a)
+	cache.put("b", b)
+	if re, ok := cache.get("a"); !ok || re != a {
+		t.Fatalf("get(a) = %v, %v", re, ok)
+	}
+
+	// b is now the least recently used pattern, so c replaces it
+	cache.put("c", c)
+	if _, ok := cache.get("b"); ok {
+		t.Error("b should have been evicted")
+	}
+	for _, expr := range []string{"a", "c"} {
+		if _, ok := cache.get(expr); !ok {
+			t.Errorf("%s should still be cached", expr)
+		}
+	}
+	if n := cache.order.Len(); n != 2 {
+		t.Errorf("cache holds %d patterns, want 2", n)
+	}
+}
+
+func TestRowCapFunction(t *testing.T) {
+	tmpDir := t.TempDir()
+	dbPath := filepath.Join(tmpDir, "test.db")
+
+	cfg := Config{
+		Path:         dbPath,
+		EmbeddingDim: 384,
+		SkipVecTable: true,
+	}
+
+	db, err := Open(cfg)
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	defer db.Close()
+
+	// An endless recursion stops at cap+1 rows and then fails the query
+	query := `WITH RECURSIVE n(x) AS (SELECT 1 UNION ALL SELECT x + 1 FROM n LIMIT ?)
+SELECT COUNT(*) FROM n WHERE (SELECT row_cap(COUNT(*), ?) FROM n)`
+
+	var count int
+	if err := db.conn.QueryRow(query, 10, 10).Scan(&count); err != nil {
+		t.Fatalf("row_cap within cap: %v", err)
+	}
+	if count != 10 {
+		t.Errorf("count = %d, want 10", count)
+	}
+
+	err = db.conn.QueryRow(query, 11, 10).Scan(&count)
+	if err == nil || !strings.Contains(err.Error(), "row cap of 10 rows") {
+		t.Errorf("Expected row cap error, got %v", err)
+	}
+	if !IsRowCapError(err) {
+		t.Errorf("IsRowCapError(%v) = false", err)
+	}
+	if strings.Contains(err.Error(), "--row-cap") {
+		t.Errorf("Row cap error should not name a command-line flag: %v", err)
+	}
+}
+
+func TestQueryPlan(t *testing.T) {
+	tmpDir := t.TempDir()
+	dbPath := filepath.Join(tmpDir, "test.db")
+
+	cfg := Config{
+		Path:         dbPath,
+		EmbeddingDim: 384,
+		SkipVecTable: true,
+	}
+
+	db, err := Open(cfg)
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	defer db.Close()
+
+	plan, err := db.QueryPlan("SELECT e.name FROM entities e WHERE e.id = ?", 1)
+	if err != nil {
+		t.Fatalf("QueryPlan failed: %v", err)
+	}
+	if !strings.Contains(plan, "SEARCH e USING INTEGER PRIMARY KEY") {
+		t.Errorf("Expected primary key lookup in plan, got:\n%s", plan)
+	}
+
+	// Subqueries are nested under the step that runs them
+	plan, err = db.QueryPlan("SELECT id FROM entities WHERE id IN (SELECT source_entity_id FROM graph_edges)")
+	if err != nil {
+		t.Fatalf("QueryPlan failed: %v", err)
+	}
+	if !strings.Contains(plan, "\n  ") {
+		t.Errorf("Expected an indented step in plan, got:\n%s", plan)
+	}
+
+	if _, err := db.QueryPlan("SELECT * FROM missing_table"); err == nil {
+		t.Error("Expected error for invalid query")
+	}
+}
+
+func TestRawQueryContext(t *testing.T) {
+	tmpDir := t.TempDir()
+	dbPath := filepath.Join(tmpDir, "test.db")
+
+	cfg := Config{
+		Path:         dbPath,
+		EmbeddingDim: 384,
+		SkipVecTable: true,
+	}
+
+	db, err := Open(cfg)
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	defer db.Close()
+
+	// Counts forever unless interrupted
+	endless := "WITH RECURSIVE n(x) AS (SELECT 1 UNION ALL SELECT x + 1 FROM n) SELECT COUNT(*) FROM n"
+	count := func(ctx context.Context, query string) (int, error) {
+		rows, err := db.RawQueryContext(ctx, query)
+		if err != nil {
+			return 0, err
+		}
+		defer rows.Close()
+		n := 0
+		for rows.Next() {
+			if err := rows.Scan(&n); err != nil {
+				return 0, err
+			}
+		}
+		return n, rows.Err()
+	}
+
+	t.Run("deadline", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+		start := time.Now()
+		if _, err := count(ctx, endless); err == nil {
+			t.Fatal("Expected the query to be interrupted")
+		}
+		if elapsed := time.Since(start); elapsed > 5*time.Second {
+			t.Errorf("Query stopped after %s, long after its deadline", elapsed)
+		}
+		if ctx.Err() != context.DeadlineExceeded {
+			t.Errorf("ctx.Err() = %v, want deadline exceeded", ctx.Err())
+		}
+	})
+
+	t.Run("cancel", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		go func() {
+			time.Sleep(50 * time.Millisecond)
+			cancel()
+		}()
+		if _, err := count(ctx, endless); err == nil {
+			t.Fatal("Expected the query to be interrupted")
+		}
+	})
+
+	// Interrupted queries leave the connections usable
+	for i := 0; i < 10; i++ {
+		n, err := count(context.Background(), "SELECT COUNT(*) FROM entities")
+		if err != nil || n != 0 {
+			t.Fatalf("Query after interrupt = %d, %v", n, err)
+		}
+	}
+}
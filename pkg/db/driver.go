@@ -0,0 +1,22 @@
+package db
+
+import (
+	"database/sql"
+
+	"github.com/mattn/go-sqlite3"
+)
+
+// driverName is the go-sqlite3 driver with chainsaw's SQL functions
+// registered on every connection
+const driverName = "sqlite3_chainsaw"
+
+func init() {
+	sql.Register(driverName, &sqlite3.SQLiteDriver{
+		ConnectHook: func(conn *sqlite3.SQLiteConn) error {
+			if err := conn.RegisterFunc("regexp", regexpMatch, true); err != nil {
+				return err
+			}
+			return conn.RegisterFunc("row_cap", rowCap, true)
+		},
+	})
+}
@@ -0,0 +1,105 @@
+package db
+
+import (
+	"container/list"
+	"fmt"
+	"regexp"
+	"sync"
+)
+
+// regexpCacheSize bounds the compiled patterns kept between calls. A query
+// calls regexp() once per row, usually with the same few patterns.
+const regexpCacheSize = 64
+
+// regexpCache holds the most recently used compiled patterns
+var regexpCache = newPatternCache(regexpCacheSize)
+
+// regexpMatch implements SQLite's regexp(pattern, value), which backs
+// "value REGEXP pattern" and Cypher's =~. Like Cypher, the pattern must match
+// the whole value. NULL in either argument yields NULL.
+func regexpMatch(pattern, value interface{}) (interface{}, error) {
+	if sqlNull(pattern) || sqlNull(value) {
+		return nil, nil
+	}
+	expr := sqlText(pattern)
+	re, ok := regexpCache.get(expr)
+	if !ok {
+		compiled, err := regexp.Compile(`^(?:` + expr + `)$`)
+		if err != nil {
+			return nil, fmt.Errorf("invalid regular expression %q: %w", expr, err)
+		}
+		re = compiled
+		regexpCache.put(expr, re)
+	}
+	return re.MatchString(sqlText(value)), nil
+}
+
+// patternCache is a least recently used cache of compiled patterns, safe
+// for the connections of the pool to share
+type patternCache struct {
+	mu      sync.Mutex
+	size    int
+	order   *list.List // most recently used first; values are cacheEntry
+	entries map[string]*list.Element
+}
+
+type cacheEntry struct {
+	expr string
+	re   *regexp.Regexp
+}
+
+func newPatternCache(size int) *patternCache {
+	return &patternCache{
+		size:    size,
+		order:   list.New(),
+		entries: map[string]*list.Element{},
+	}
+}
+
+// get returns the compiled pattern for expr and marks it as recently used
+func (c *patternCache) get(expr string) (*regexp.Regexp, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	elem, ok := c.entries[expr]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(elem)
+	return elem.Value.(cacheEntry).re, true
+}
+
+// put adds a compiled pattern, evicting the least recently used one when
+// the cache is full
+func (c *patternCache) put(expr string, re *regexp.Regexp) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if elem, ok := c.entries[expr]; ok {
+		c.order.MoveToFront(elem)
+		return
+	}
+	c.entries[expr] = c.order.PushFront(cacheEntry{expr, re})
+	if c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(cacheEntry).expr)
+	}
+}
+
+// sqlNull reports whether a function argument is NULL, which go-sqlite3
+// passes as a nil []byte
+func sqlNull(v interface{}) bool {
+	b, ok := v.([]byte)
+	return v == nil || (ok && b == nil)
+}
+
+// sqlText converts a SQLite value passed to a Go function into text
+func sqlText(v interface{}) string {
+	switch s := v.(type) {
+	case string:
+		return s
+	case []byte:
+		return string(s)
+	default:
+		return fmt.Sprint(s)
+	}
+}
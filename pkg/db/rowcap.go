@@ -0,0 +1,27 @@
+package db
+
+import (
+	"fmt"
+	"strings"
+)
+
+// rowCapExceeded starts the error of row_cap(), which reaches callers only
+// as the text of a SQLite error
+const rowCapExceeded = "variable-length relation exceeded the row cap"
+
+// rowCap implements row_cap(count, cap), which the Cypher transpiler places
+// on every recursive CTE. The CTE stops after cap+1 rows; seeing more than
+// cap rows means the traversal was cut short, so the query fails rather
+// than return incomplete results.
+func rowCap(count, limit int64) (bool, error) {
+	if count > limit {
+		return false, fmt.Errorf("%s of %d rows", rowCapExceeded, limit)
+	}
+	return true, nil
+}
+
+// IsRowCapError reports whether a query failed because a variable-length
+// relation produced more rows than its row cap
+func IsRowCapError(err error) bool {
+	return err != nil && strings.Contains(err.Error(), rowCapExceeded)
+}
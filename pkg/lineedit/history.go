@@ -0,0 +1,98 @@
+package lineedit
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode"
+)
+
+// DefaultHistorySize is the number of entries a history file keeps
+const DefaultHistorySize = 1000
+
+// historyEscaper and historyUnescaper store an entry on one line of the
+// history file, escaping backslashes and the line breaks of multi-line
+// entries
+var (
+	historyEscaper   = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+	historyUnescaper = strings.NewReplacer(`\\`, `\`, `\n`, "\n")
+)
+
+// LoadHistory reads previous entries from path, one per line, and appends
+// new entries to it from then on. A missing file is not an error. Files
+// grown past the history size are trimmed to the most recent entries.
+func (e *Editor) LoadHistory(path string) error {
+	e.historyPath = path
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to open history: %w", err)
+	}
+	defer f.Close()
+
+	var entries []string
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		if entry := scanner.Text(); entry != "" {
+			entries = append(entries, historyUnescaper.Replace(entry))
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read history: %w", err)
+	}
+
+	if len(entries) > e.historyMax {
+		entries = entries[len(entries)-e.historyMax:]
+		var content strings.Builder
+		for _, entry := range entries {
+			content.WriteString(historyEscaper.Replace(entry) + "\n")
+		}
+		if err := os.WriteFile(path, []byte(content.String()), 0600); err != nil {
+			return fmt.Errorf("failed to trim history: %w", err)
+		}
+	}
+	e.history = entries
+	return nil
+}
+
+// AddHistory adds an entry that Up and Ctrl-P recall, and appends it to the
+// history file if one was loaded. Empty entries and repeats of the last
+// entry are skipped. A multi-line entry keeps its line breaks, so a //
+// comment still ends at its line, but drops blank lines and trailing spaces.
+func (e *Editor) AddHistory(entry string) error {
+	var lines []string
+	for _, line := range strings.Split(entry, "\n") {
+		if line = strings.TrimRightFunc(line, unicode.IsSpace); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	entry = strings.Join(lines, "\n")
+	if entry == "" || (len(e.history) > 0 && e.history[len(e.history)-1] == entry) {
+		return nil
+	}
+	e.history = append(e.history, entry)
+	if len(e.history) > e.historyMax {
+		e.history = e.history[len(e.history)-e.historyMax:]
+	}
+
+	if e.historyPath == "" {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(e.historyPath), 0755); err != nil {
+		return fmt.Errorf("failed to create history directory: %w", err)
+	}
+	f, err := os.OpenFile(e.historyPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to open history: %w", err)
+	}
+	defer f.Close()
+	if _, err := f.WriteString(historyEscaper.Replace(entry) + "\n"); err != nil {
+		return fmt.Errorf("failed to write history: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,455 @@
+// Package lineedit reads lines from a terminal with editing, history and tab
+// completion. When input is not a terminal it reads plain lines, so the same
+// loop can run piped scripts.
+package lineedit
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"unicode"
+
+	"golang.org/x/term"
+)
+
+// ErrInterrupt is returned by ReadLine when the user presses Ctrl-C
+var ErrInterrupt = errors.New("interrupted")
+
+// CompleteFunc completes the word before the cursor. It receives the text
+// before the cursor and returns the byte offset in it where the word starts
+// and the words that may replace it.
+type CompleteFunc func(head string) (start int, candidates []string)
+
+// Editor reads lines from a terminal
+type Editor struct {
+	in          *bufio.Reader
+	out         io.Writer
+	fd          int
+	interactive bool
+	width       func() int
+
+	// Complete, when set, is called on Tab
+	Complete CompleteFunc
+
+	history     []string
+	historyPath string
+	historyMax  int
+}
+
+// New creates an editor reading from in and echoing to out. Editing is only
+// enabled when in is a terminal.
+func New(in io.Reader, out io.Writer) *Editor {
+	e := &Editor{
+		in:         bufio.NewReader(in),
+		out:        out,
+		fd:         -1,
+		width:      func() int { return 80 },
+		historyMax: DefaultHistorySize,
+	}
+	if f, ok := in.(*os.File); ok && term.IsTerminal(int(f.Fd())) {
+		e.fd = int(f.Fd())
+		e.interactive = true
+		e.width = func() int {
+			if cols, _, err := term.GetSize(e.fd); err == nil && cols > 0 {
+				return cols
+			}
+			return 80
+		}
+	}
+	return e
+}
+
+// Interactive reports whether the editor reads from a terminal
+func (e *Editor) Interactive() bool {
+	return e.interactive
+}
+
+// ReadLine prints prompt and returns the line the user enters, without the
+// line ending. It returns ErrInterrupt on Ctrl-C and io.EOF on Ctrl-D on an
+// empty line or at the end of input. Without a terminal the prompt is not
+// printed.
+func (e *Editor) ReadLine(prompt string) (string, error) {
+	if !e.interactive {
+		return e.readPlain()
+	}
+	// In raw mode keys are read one by one without echo, and Ctrl-C arrives
+	// as a key rather than a signal
+	state, err := term.MakeRaw(e.fd)
+	if err != nil {
+		return "", fmt.Errorf("failed to enable raw mode: %w", err)
+	}
+	defer term.Restore(e.fd, state)
+	return e.edit(prompt)
+}
+
+// readPlain reads a line without editing
+func (e *Editor) readPlain() (string, error) {
+	line, err := e.in.ReadString('\n')
+	if err == io.EOF && line != "" {
+		err = nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+// Control keys
+const (
+	keyCtrlA     = 1
+	keyCtrlB     = 2
+	keyCtrlC     = 3
+	keyCtrlD     = 4
+	keyCtrlE     = 5
+	keyCtrlF     = 6
+	keyCtrlH     = 8
+	keyTab       = 9
+	keyLineFeed  = 10
+	keyCtrlK     = 11
+	keyCtrlL     = 12
+	keyEnter     = 13
+	keyCtrlN     = 14
+	keyCtrlP     = 16
+	keyCtrlU     = 21
+	keyCtrlW     = 23
+	keyEscape    = 27
+	keyBackspace = 127
+)
+
+// line is the state of the line being edited
+type line struct {
+	prompt []rune
+	buf    []rune
+	pos    int
+
+	cursorRow int // terminal row of the cursor, relative to the prompt
+}
+
+// edit runs the editing loop of ReadLine on a terminal in raw mode
+func (e *Editor) edit(prompt string) (string, error) {
+	l := &line{prompt: []rune(prompt)}
+	e.render(l)
+
+	// history[len(history)] is the line being edited
+	index := len(e.history)
+	saved := ""
+	tabs := 0
+
+	for {
+		r, _, err := e.in.ReadRune()
+		if err != nil {
+			if err == io.EOF && len(l.buf) > 0 {
+				e.finish(l)
+				return string(l.buf), nil
+			}
+			return "", err
+		}
+		if r == keyTab {
+			tabs++
+		} else {
+			tabs = 0
+		}
+
+		switch r {
+		case keyEnter, keyLineFeed:
+			e.finish(l)
+			return string(l.buf), nil
+		case keyCtrlC:
+			l.pos = len(l.buf)
+			e.render(l)
+			io.WriteString(e.out, "^C\r\n")
+			return "", ErrInterrupt
+		case keyCtrlD:
+			if len(l.buf) == 0 {
+				io.WriteString(e.out, "\r\n")
+				return "", io.EOF
+			}
+			l.delete()
+		case keyTab:
+			e.complete(l, tabs)
+		case keyBackspace, keyCtrlH:
+			l.backspace()
+		case keyCtrlA:
+			l.pos = 0
+		case keyCtrlE:
+			l.pos = len(l.buf)
+		case keyCtrlB:
+			l.left()
+		case keyCtrlF:
+			l.right()
+		case keyCtrlK:
+			l.buf = l.buf[:l.pos]
+		case keyCtrlU:
+			l.buf = l.buf[l.pos:]
+			l.pos = 0
+		case keyCtrlW:
+			l.deleteWord()
+		case keyCtrlL:
+			io.WriteString(e.out, "\x1b[H\x1b[2J")
+			l.cursorRow = 0
+		case keyCtrlP:
+			index, saved = e.recall(l, index, index-1, saved)
+		case keyCtrlN:
+			index, saved = e.recall(l, index, index+1, saved)
+		case keyEscape:
+			switch e.readEscape() {
+			case "A":
+				index, saved = e.recall(l, index, index-1, saved)
+			case "B":
+				index, saved = e.recall(l, index, index+1, saved)
+			case "C":
+				l.right()
+			case "D":
+				l.left()
+			case "H", "1~", "7~":
+				l.pos = 0
+			case "F", "4~", "8~":
+				l.pos = len(l.buf)
+			case "3~":
+				l.delete()
+			}
+		default:
+			if unicode.IsPrint(r) {
+				l.insert([]rune{r})
+			}
+		}
+		e.render(l)
+	}
+}
+
+// readEscape reads the rest of an escape sequence such as ESC [ A and returns
+// what follows the bracket
+func (e *Editor) readEscape() string {
+	b, err := e.in.ReadByte()
+	if err != nil || (b != '[' && b != 'O') {
+		return ""
+	}
+	var seq []byte
+	for {
+		c, err := e.in.ReadByte()
+		if err != nil {
+			return ""
+		}
+		seq = append(seq, c)
+		// Parameters are digits and ';', the final byte is a letter or '~'
+		if c >= 0x40 && c <= 0x7e {
+			return string(seq)
+		}
+	}
+}
+
+// recall replaces the line with history entry to, keeping the edited line
+// to come back to
+func (e *Editor) recall(l *line, from, to int, saved string) (int, string) {
+	if to < 0 || to > len(e.history) {
+		return from, saved
+	}
+	if from == len(e.history) {
+		saved = string(l.buf)
+	}
+	if to == len(e.history) {
+		l.buf = []rune(saved)
+	} else {
+		l.buf = []rune(e.history[to])
+	}
+	l.pos = len(l.buf)
+	return to, saved
+}
+
+// complete completes the word before the cursor. The first Tab inserts what
+// all candidates share, the second lists them.
+func (e *Editor) complete(l *line, tabs int) {
+	if e.Complete == nil {
+		return
+	}
+	head := string(l.buf[:l.pos])
+	start, candidates := e.Complete(head)
+	if len(candidates) == 0 || start < 0 || start > len(head) {
+		io.WriteString(e.out, "\a")
+		return
+	}
+	word := head[start:]
+	prefix := commonPrefix(candidates)
+	if len(candidates) == 1 || (len(prefix) > len(word) && strings.HasPrefix(strings.ToLower(prefix), strings.ToLower(word))) {
+		l.replaceBefore(len([]rune(word)), []rune(prefix))
+		return
+	}
+	if tabs < 2 {
+		io.WriteString(e.out, "\a")
+		return
+	}
+
+	// List the candidates below the line; it is redrawn after them
+	pos := l.pos
+	l.pos = len(l.buf)
+	e.render(l)
+	io.WriteString(e.out, "\r\n"+formatColumns(candidates, e.width())+"\r\n")
+	l.cursorRow = 0
+	l.pos = pos
+}
+
+// finish moves the cursor past the end of the line and starts a new one
+func (e *Editor) finish(l *line) {
+	l.pos = len(l.buf)
+	e.render(l)
+	io.WriteString(e.out, "\r\n")
+}
+
+// render redraws the prompt and line, which may wrap over several terminal
+// rows and hold the line breaks of a recalled multi-line entry, and puts the
+// cursor in place
+func (e *Editor) render(l *line) {
+	cols := e.width()
+	var w strings.Builder
+
+	// Back to the start of the prompt, clearing what was drawn before
+	if l.cursorRow > 0 {
+		fmt.Fprintf(&w, "\x1b[%dA", l.cursorRow)
+	}
+	w.WriteString("\r\x1b[J")
+	w.WriteString(string(l.prompt))
+	w.WriteString(strings.ReplaceAll(string(l.buf), "\n", "\r\n"))
+
+	// A line filling the last row exactly leaves the cursor on that row;
+	// move it to the next so the row arithmetic below holds
+	text := append(append([]rune{}, l.prompt...), l.buf...)
+	end, endCol := position(text, cols)
+	if endCol == cols {
+		w.WriteString("\r\n")
+		end++
+	}
+
+	row, col := position(text[:len(l.prompt)+l.pos], cols)
+	if col == cols {
+		row, col = row+1, 0
+	}
+	if up := end - row; up > 0 {
+		fmt.Fprintf(&w, "\x1b[%dA", up)
+	}
+	w.WriteString("\r")
+	if col > 0 {
+		fmt.Fprintf(&w, "\x1b[%dC", col)
+	}
+	l.cursorRow = row
+
+	io.WriteString(e.out, w.String())
+}
+
+// position returns the terminal row and column reached by writing text at
+// the start of a row. A row filled exactly leaves the column at cols, as
+// terminals only wrap when the next character arrives.
+func position(text []rune, cols int) (row, col int) {
+	for _, r := range text {
+		if r == '\n' {
+			row, col = row+1, 0
+			continue
+		}
+		if col == cols {
+			row, col = row+1, 0
+		}
+		col++
+	}
+	return row, col
+}
+
+func (l *line) insert(runes []rune) {
+	buf := make([]rune, 0, len(l.buf)+len(runes))
+	buf = append(buf, l.buf[:l.pos]...)
+	buf = append(buf, runes...)
+	l.buf = append(buf, l.buf[l.pos:]...)
+	l.pos += len(runes)
+}
+
+// replaceBefore replaces the n runes before the cursor
+func (l *line) replaceBefore(n int, runes []rune) {
+	l.buf = append(l.buf[:l.pos-n], l.buf[l.pos:]...)
+	l.pos -= n
+	l.insert(runes)
+}
+
+func (l *line) backspace() {
+	if l.pos > 0 {
+		l.buf = append(l.buf[:l.pos-1], l.buf[l.pos:]...)
+		l.pos--
+	}
+}
+
+func (l *line) delete() {
+	if l.pos < len(l.buf) {
+		l.buf = append(l.buf[:l.pos], l.buf[l.pos+1:]...)
+	}
+}
+
+// deleteWord deletes the word before the cursor and the spaces after it
+func (l *line) deleteWord() {
+	start := l.pos
+	for start > 0 && unicode.IsSpace(l.buf[start-1]) {
+		start--
+	}
+	for start > 0 && !unicode.IsSpace(l.buf[start-1]) {
+		start--
+	}
+	l.buf = append(l.buf[:start], l.buf[l.pos:]...)
+	l.pos = start
+}
+
+func (l *line) left() {
+	if l.pos > 0 {
+		l.pos--
+	}
+}
+
+func (l *line) right() {
+	if l.pos < len(l.buf) {
+		l.pos++
+	}
+}
+
+// commonPrefix returns the longest prefix the words share
+func commonPrefix(words []string) string {
+	prefix := words[0]
+	for _, w := range words[1:] {
+		for !strings.HasPrefix(w, prefix) {
+			prefix = prefix[:len(prefix)-1]
+		}
+	}
+	return prefix
+}
+
+// formatColumns lays out words in sorted columns that fit the width
+func formatColumns(words []string, width int) string {
+	sorted := append([]string(nil), words...)
+	sort.Strings(sorted)
+	colWidth := 0
+	for _, w := range sorted {
+		if len(w) > colWidth {
+			colWidth = len(w)
+		}
+	}
+	colWidth += 2
+	perRow := width / colWidth
+	if perRow < 1 {
+		perRow = 1
+	}
+
+	var rows []string
+	for i := 0; i < len(sorted); i += perRow {
+		end := i + perRow
+		if end > len(sorted) {
+			end = len(sorted)
+		}
+		var row strings.Builder
+		for j, w := range sorted[i:end] {
+			if j < end-i-1 {
+				w += strings.Repeat(" ", colWidth-len(w))
+			}
+			row.WriteString(w)
+		}
+		rows = append(rows, row.String())
+	}
+	return strings.Join(rows, "\r\n")
+}
@@ -0,0 +1,266 @@
+package lineedit
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// newTerminalEditor returns an editor that edits keys from input as if they
+// were typed on a terminal
+func newTerminalEditor(input string) *Editor {
+	e := New(strings.NewReader(input), &bytes.Buffer{})
+	e.interactive = true
+	return e
+}
+
+func TestEditing(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"typing", "MATCH (n)\r", "MATCH (n)"},
+		{"line feed", "abc\n", "abc"},
+		{"backspace", "abx\x7fc\r", "abc"},
+		{"insert after left arrow", "abd\x1b[Dc\r", "abcd"},
+		{"right arrow", "ac\x1b[D\x1b[Cd\r", "acd"},
+		{"home and end", "bc\x1b[Ha\x1b[Fd\r", "abcd"},
+		{"ctrl-a and ctrl-e", "bc\x01a\x05d\r", "abcd"},
+		{"delete key", "abxc\x1b[D\x1b[D\x1b[3~\r", "abc"},
+		{"ctrl-k", "abcdef\x01\x06\x06\x06\x0b\r", "abc"},
+		{"ctrl-u", "xyzabc\x1b[D\x1b[D\x1b[D\x15\r", "abc"},
+		{"ctrl-w", "MATCH (n) RETURN  \x17\r", "MATCH (n) "},
+		{"unicode", "naïx\x7fve\r", "naïve"},
+		{"end of input", "abc", "abc"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := newTerminalEditor(tt.input).edit("> ")
+			if err != nil {
+				t.Fatalf("edit() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("edit() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInterruptAndEOF(t *testing.T) {
+	if _, err := newTerminalEditor("abc\x03").edit("> "); err != ErrInterrupt {
+		t.Errorf("Ctrl-C: error = %v, want ErrInterrupt", err)
+	}
+	if _, err := newTerminalEditor("\x04").edit("> "); err != io.EOF {
+		t.Errorf("Ctrl-D on empty line: error = %v, want io.EOF", err)
+	}
+	got, err := newTerminalEditor("abc\x01\x04\r").edit("> ")
+	if err != nil || got != "bc" {
+		t.Errorf("Ctrl-D on a line = %q, %v, want it to delete a character", got, err)
+	}
+}
+
+func TestHistoryRecall(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"previous", "\x1b[A\r", "second"},
+		{"before previous", "\x1b[A\x1b[A\r", "first"},
+		{"stops at the oldest", "\x1b[A\x1b[A\x1b[A\r", "first"},
+		{"back to the edited line", "new\x1b[A\x1b[A\x1b[B\x1b[B\r", "new"},
+		{"ctrl-p and ctrl-n", "\x10\x10\x0e\r", "second"},
+		{"edit a recalled entry", "\x1b[A!\r", "second!"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := newTerminalEditor(tt.input)
+			e.AddHistory("first")
+			e.AddHistory("second")
+			got, err := e.edit("> ")
+			if err != nil {
+				t.Fatalf("edit() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("edit() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHistoryFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sub", "history")
+
+	e := New(strings.NewReader(""), io.Discard)
+	if err := e.LoadHistory(path); err != nil {
+		t.Fatalf("LoadHistory() on a missing file: %v", err)
+	}
+	entries := []string{
+		"MATCH (a) // any node  \n\n  RETURN a.name;",
+		"MATCH (a) // any node\n  RETURN a.name;",
+		"",
+		`MATCH (a) WHERE a.name =~ '\\w+\\n' RETURN a;`,
+		":schema",
+	}
+	for _, entry := range entries {
+		if err := e.AddHistory(entry); err != nil {
+			t.Fatalf("AddHistory() error = %v", err)
+		}
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Failed to read history: %v", err)
+	}
+	want := `MATCH (a) // any node\n  RETURN a.name;` + "\n" +
+		`MATCH (a) WHERE a.name =~ '\\\\w+\\\\n' RETURN a;` + "\n" +
+		":schema\n"
+	if string(data) != want {
+		t.Errorf("History file = %q, want %q", data, want)
+	}
+
+	// A new editor recalls the entries with their line breaks
+	e = newTerminalEditor("\x1b[A\x1b[A\x1b[A\r")
+	if err := e.LoadHistory(path); err != nil {
+		t.Fatalf("LoadHistory() error = %v", err)
+	}
+	got, err := e.edit("> ")
+	if err != nil {
+		t.Fatalf("edit() error = %v", err)
+	}
+	if got != entries[1] {
+		t.Errorf("Recalled %q, want %q", got, entries[1])
+	}
+	if e.history[1] != entries[3] {
+		t.Errorf("Loaded %q, want %q", e.history[1], entries[3])
+	}
+
+	// Loading trims the file to the history size
+	e = newTerminalEditor("\x1b[A\x1b[A\r")
+	e.historyMax = 2
+	if err := e.LoadHistory(path); err != nil {
+		t.Fatalf("LoadHistory() error = %v", err)
+	}
+	got, err = e.edit("> ")
+	if err != nil {
+		t.Fatalf("edit() error = %v", err)
+	}
+	if got != entries[3] {
+		t.Errorf("Recalled %q, want %q", got, entries[3])
+	}
+	data, _ = os.ReadFile(path)
+	if want := want[strings.Index(want, "\n")+1:]; string(data) != want {
+		t.Errorf("Trimmed history file = %q, want %q", data, want)
+	}
+}
+
+func TestCompletion(t *testing.T) {
+	labels := []string{"FUNCTION", "STRUCT", "STRING"}
+	complete := func(head string) (int, []string) {
+		start := strings.LastIndex(head, ":") + 1
+		var candidates []string
+		for _, label := range labels {
+			if strings.HasPrefix(label, head[start:]) {
+				candidates = append(candidates, label)
+			}
+		}
+		return start, candidates
+	}
+
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"single candidate", "(f:FU\t)\r", "(f:FUNCTION)"},
+		{"common prefix", "(s:S\t\r", "(s:STR"},
+		{"in the middle of the line", "(f:F)\x1b[D\t\r", "(f:FUNCTION)"},
+		{"no candidates", "(x:X\t\r", "(x:X"},
+		{"listing keeps the line", "(s:STR\t\t\r", "(s:STR"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := newTerminalEditor(tt.input)
+			e.Complete = complete
+			got, err := e.edit("> ")
+			if err != nil {
+				t.Fatalf("edit() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("edit() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	// The second Tab lists the candidates
+	out := &bytes.Buffer{}
+	e := New(strings.NewReader("(s:STR\t\t\r"), out)
+	e.interactive = true
+	e.Complete = complete
+	e.edit("> ")
+	if !strings.Contains(out.String(), "STRING  STRUCT") {
+		t.Errorf("Candidates not listed:\n%q", out.String())
+	}
+}
+
+func TestRenderWraps(t *testing.T) {
+	e := newTerminalEditor("")
+	e.width = func() int { return 10 }
+
+	l := &line{prompt: []rune("> "), buf: []rune("0123456789abcdef")}
+	l.pos = len(l.buf)
+	e.render(l)
+	if l.cursorRow != 1 {
+		t.Errorf("Cursor row at the end = %d, want 1", l.cursorRow)
+	}
+
+	l.pos = 2
+	e.render(l)
+	if l.cursorRow != 0 {
+		t.Errorf("Cursor row at the start = %d, want 0", l.cursorRow)
+	}
+
+	// Filling the row exactly puts the cursor on the next one
+	l.buf = []rune("01234567")
+	l.pos = len(l.buf)
+	e.render(l)
+	if l.cursorRow != 1 {
+		t.Errorf("Cursor row after a full row = %d, want 1", l.cursorRow)
+	}
+
+	// A line break starts a new row, also after a full one
+	l.buf = []rune("01234567\nab\ncd")
+	l.pos = len(l.buf)
+	e.render(l)
+	if l.cursorRow != 2 {
+		t.Errorf("Cursor row after line breaks = %d, want 2", l.cursorRow)
+	}
+	l.pos = 10
+	e.render(l)
+	if l.cursorRow != 1 {
+		t.Errorf("Cursor row on the second line = %d, want 1", l.cursorRow)
+	}
+}
+
+func TestReadPlain(t *testing.T) {
+	e := New(strings.NewReader("MATCH (n)\r\nRETURN n;\nlast"), io.Discard)
+	if e.Interactive() {
+		t.Fatal("Editor on a reader should not be interactive")
+	}
+	for _, want := range []string{"MATCH (n)", "RETURN n;", "last"} {
+		got, err := e.ReadLine("> ")
+		if err != nil || got != want {
+			t.Errorf("ReadLine() = %q, %v, want %q", got, err, want)
+		}
+	}
+	if _, err := e.ReadLine("> "); err != io.EOF {
+		t.Errorf("ReadLine() at the end: error = %v, want io.EOF", err)
+	}
+}